@@ -1889,6 +1889,18 @@ provider "aws" {
 `, os.Getenv(envvar.AccAssumeRoleARN), policy)
 }
 
+// ConfigWithEchoProvider returns a configuration that passes the specified ephemeral resource data
+// through the echo provider so that it can be checked in state.
+func ConfigWithEchoProvider(ephemeralResourceData string) string {
+	return fmt.Sprintf(`
+provider "echo" {
+  data = %[1]s
+}
+
+resource "echo" "test" {}
+`, ephemeralResourceData)
+}
+
 const testAccProviderConfigBase = `
 data "aws_region" "provider_test" {}
 
@@ -5,5 +5,8 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder  = closeVCRRecorder
+	VCRCanonicalBody  = vcrCanonicalBody
+	VCRCanonicalURL   = vcrCanonicalURL
+	VCRScrubAccountID = vcrScrubAccountID
 )
@@ -7,15 +7,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -121,7 +118,15 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			configureProvider := primary.ConfigureProvider
+			configureContextFunc := vcrProviderConfigureContextFunc(primary, func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+				var response schema.ConfigureProviderResponse
+				configureProvider(ctx, schema.ConfigureProviderRequest{ResourceData: d}, &response)
+				return response.Meta, response.Diagnostics
+			}, t.Name())
+			primary.ConfigureProvider = func(ctx context.Context, request schema.ConfigureProviderRequest, response *schema.ConfigureProviderResponse) {
+				response.Meta, response.Diagnostics = configureContextFunc(ctx, request.ResourceData)
+			}
 
 			return providerServerFactory(), nil
 		}
@@ -131,7 +136,7 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 }
 
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
-// This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
+// This is necessary as the provider's configuration function is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
 func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -183,81 +188,47 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}, recorder.AfterCaptureHook)
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+		// Replace the recording account's ID in saved cassettes.
+		r.AddHook(func(i *cassette.Interaction) error {
+			if meta != nil {
+				vcrScrubAccountID(i, meta.AccountID)
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			return nil
+		}, recorder.BeforeSaveHook)
 
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+		// Defines how VCR will match requests to responses.
+		// Bodies are compared after canonicalization, ignoring idempotency tokens, generated unique IDs and timestamps.
+		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
+			var body string
+			if r.Body != nil {
+				var b bytes.Buffer
+				if _, err := b.ReadFrom(r.Body); err != nil {
+					tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
 						"error": err,
 					})
 					return false
 				}
 
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+				r.Body = io.NopCloser(&b)
+				body = b.String()
+			}
 
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			ok, err := vcrRequestsMatch(r.Method, r.URL.String(), r.Header.Get("Content-Type"), body, i)
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to canonicalize request body", map[string]interface{}{
+					"error": err,
+				})
+				return false
 			}
 
-			return false
+			return ok
 		})
 
 		// Use the wrapped HTTP Client for AWS APIs.
-		// As the HTTP client is used in the provider's ConfigureContextFunc
-		// we must do this setup before calling the ConfigureContextFunc.
+		// As the HTTP client is used in the provider's configuration function
+		// we must do this setup before calling the configuration function.
 		httpClient.Transport = r
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
@@ -473,3 +444,43 @@ func RandomWithPrefix(t *testing.T, prefix string) string {
 
 	return fmt.Sprintf("%s-%d", prefix, RandInt(t))
 }
+
+// RandIntRange is a VCR-friendly replacement for acctest.RandIntRange.
+func RandIntRange(t *testing.T, minInt, maxInt int) int {
+	t.Helper()
+
+	if !isVCREnabled() {
+		return sdkacctest.RandIntRange(minInt, maxInt)
+	}
+
+	s, err := vcrRandomnessSource(t)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return minInt + rand.New(s.source).Intn(maxInt-minInt)
+}
+
+// RandString is a VCR-friendly replacement for acctest.RandString.
+func RandString(t *testing.T, n int) string {
+	t.Helper()
+
+	if !isVCREnabled() {
+		return sdkacctest.RandString(n)
+	}
+
+	s, err := vcrRandomnessSource(t)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := rand.New(s.source)
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = sdkacctest.CharSetAlpha[r.Intn(len(sdkacctest.CharSetAlpha))]
+	}
+
+	return string(b)
+}
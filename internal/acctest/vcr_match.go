@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrAccountID replaces the recording account's ID in saved cassettes.
+	vcrAccountID = "123456789012"
+)
+
+var (
+	// Generated by terraform-plugin-sdk's id.PrefixedUniqueId: 18 timestamp digits followed by 8 counter hex digits.
+	vcrUniqueIDRegexp  = regexache.MustCompile(`\d{18}[0-9a-f]{8}`)
+	vcrTimestampRegexp = regexache.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+)
+
+// vcrIdempotencyTokenNames are the request parameters ignored when matching requests.
+// Their values are generated randomly for each request.
+var vcrIdempotencyTokenNames = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+	"RequestToken",
+}
+
+func isVCRIdempotencyToken(name string) bool {
+	return slices.ContainsFunc(vcrIdempotencyTokenNames, func(v string) bool {
+		return strings.EqualFold(v, name)
+	})
+}
+
+// vcrCanonicalString replaces generated unique IDs and timestamps with fixed placeholders.
+func vcrCanonicalString(s string) string {
+	s = vcrUniqueIDRegexp.ReplaceAllString(s, "<id>")
+	s = vcrTimestampRegexp.ReplaceAllString(s, "<time>")
+
+	return s
+}
+
+// vcrCanonicalURL returns a canonical form of the specified URL, ignoring idempotency tokens in the query string.
+func vcrCanonicalURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return vcrCanonicalString(s)
+	}
+
+	u.Path = vcrCanonicalString(u.Path)
+	u.RawPath = ""
+	u.RawQuery = vcrCanonicalQuery(u.Query())
+
+	return u.String()
+}
+
+// vcrCanonicalQuery returns a canonical form of the specified query string or form values, ignoring idempotency tokens.
+// AWS Query protocol list members, e.g. `ClientToken` in `Foo.member.1.ClientToken`, are matched on their final component.
+func vcrCanonicalQuery(values url.Values) string {
+	canonical := make(url.Values, len(values))
+
+	for k, v := range values {
+		if isVCRIdempotencyToken(k[strings.LastIndex(k, ".")+1:]) {
+			continue
+		}
+
+		for _, v := range v {
+			canonical.Add(vcrCanonicalString(k), vcrCanonicalString(v))
+		}
+	}
+
+	// Encode sorts by key.
+	return canonical.Encode()
+}
+
+// vcrCanonicalBody returns a canonical form of the specified request body.
+// JSON and XML documents are normalized so that element order and formatting don't matter,
+// and idempotency tokens are removed.
+func vcrCanonicalBody(contentType, body string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentType
+	}
+
+	switch {
+	case body == "":
+		return "", nil
+
+	// https://smithy.io/2.0/aws/protocols/index.html.
+	case mediaType == "application/json", strings.HasPrefix(mediaType, "application/x-amz-json-"), strings.HasSuffix(mediaType, "+json"):
+		var v any
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			return "", err
+		}
+
+		// Map keys are sorted when marshaling.
+		b, err := json.Marshal(vcrCanonicalJSON(v))
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+		if err != nil {
+			return "", err
+		}
+
+		return vcrCanonicalQuery(values), nil
+
+	case mediaType == "application/xml", mediaType == "text/xml":
+		root, err := parseVCRXMLNode(body)
+		if err != nil {
+			return "", err
+		}
+
+		return root.String(), nil
+	}
+
+	return vcrCanonicalString(body), nil
+}
+
+func vcrCanonicalJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			if isVCRIdempotencyToken(k) {
+				continue
+			}
+			m[k] = vcrCanonicalJSON(v)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, v := range v {
+			s[i] = vcrCanonicalJSON(v)
+		}
+		return s
+	case string:
+		return vcrCanonicalString(v)
+	default:
+		return v
+	}
+}
+
+// vcrXMLNode is a minimal XML element tree used to compare XML documents.
+type vcrXMLNode struct {
+	attrs    []string
+	children []*vcrXMLNode
+	name     string
+	text     string
+}
+
+func parseVCRXMLNode(body string) (*vcrXMLNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	root := &vcrXMLNode{}
+	stack := []*vcrXMLNode{root}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			node := &vcrXMLNode{name: token.Name.Local}
+			for _, attr := range token.Attr {
+				node.attrs = append(node.attrs, attr.Name.Local+"="+vcrCanonicalString(attr.Value))
+			}
+			slices.Sort(node.attrs)
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			parent.text += strings.TrimSpace(string(token))
+		}
+	}
+
+	return root, nil
+}
+
+// String returns the canonical form of the node, with idempotency tokens removed and children sorted.
+func (n *vcrXMLNode) String() string {
+	var b bytes.Buffer
+	n.write(&b)
+	return b.String()
+}
+
+func (n *vcrXMLNode) write(b *bytes.Buffer) {
+	b.WriteString("<" + n.name)
+	for _, attr := range n.attrs {
+		b.WriteString(" " + attr)
+	}
+	b.WriteString(">")
+	b.WriteString(vcrCanonicalString(n.text))
+
+	var children []string
+	for _, child := range n.children {
+		if isVCRIdempotencyToken(child.name) {
+			continue
+		}
+		children = append(children, child.String())
+	}
+	slices.Sort(children)
+	for _, child := range children {
+		b.WriteString(child)
+	}
+
+	b.WriteString("</" + n.name + ">")
+}
+
+// vcrRequestsMatch returns whether a request matches a recorded request.
+// Methods must be identical; URLs and bodies must be identical after canonicalization.
+func vcrRequestsMatch(method, url, contentType, body string, i cassette.Request) (bool, error) {
+	if method != i.Method {
+		return false, nil
+	}
+
+	if url != i.URL && vcrCanonicalURL(url) != vcrCanonicalURL(i.URL) {
+		return false, nil
+	}
+
+	if body == i.Body {
+		return true, nil
+	}
+
+	requestBody, err := vcrCanonicalBody(contentType, body)
+	if err != nil {
+		return false, err
+	}
+
+	cassetteBody, err := vcrCanonicalBody(contentType, i.Body)
+	if err != nil {
+		return false, err
+	}
+
+	return requestBody == cassetteBody, nil
+}
+
+// vcrScrubAccountID replaces the recording account's ID in an interaction.
+func vcrScrubAccountID(i *cassette.Interaction, accountID string) {
+	if accountID == "" || accountID == vcrAccountID {
+		return
+	}
+
+	scrub := func(s string) string {
+		return strings.ReplaceAll(s, accountID, vcrAccountID)
+	}
+	scrubHeaders := func(h http.Header) {
+		for _, v := range h {
+			for i := range v {
+				v[i] = scrub(v[i])
+			}
+		}
+	}
+
+	i.Request.URL = scrub(i.Request.URL)
+	i.Request.Body = scrub(i.Request.Body)
+	for _, v := range i.Request.Form {
+		for i := range v {
+			v[i] = scrub(v[i])
+		}
+	}
+	scrubHeaders(i.Request.Headers)
+	i.Response.Body = scrub(i.Response.Body)
+	scrubHeaders(i.Response.Headers)
+}
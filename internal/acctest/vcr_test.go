@@ -4,9 +4,13 @@
 package acctest_test
 
 import (
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) {
@@ -52,3 +56,169 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestRandString(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	t.Setenv("VCR_PATH", t.TempDir())
+
+	t.Setenv("VCR_MODE", "RECORDING")
+	rec1 := acctest.RandString(t, 10)
+	rec2 := acctest.RandIntRange(t, 100, 200)
+	acctest.CloseVCRRecorder(ctx, t)
+
+	t.Setenv("VCR_MODE", "REPLAYING")
+	rep1 := acctest.RandString(t, 10)
+	rep2 := acctest.RandIntRange(t, 100, 200)
+
+	if rep1 != rec1 {
+		t.Errorf("REPLAYING: %s, RECORDING: %s", rep1, rec1)
+	}
+	if rep2 != rec2 {
+		t.Errorf("REPLAYING: %d, RECORDING: %d", rep2, rec2)
+	}
+	if rep2 < 100 || rep2 >= 200 {
+		t.Errorf("out of range: %d", rep2)
+	}
+}
+
+func TestVCRCanonicalBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType string
+		body1       string
+		body2       string
+		expectEqual bool
+	}{
+		"JSON reordered": {
+			contentType: "application/x-amz-json-1.1",
+			body1:       `{"Name":"tf-acc-test","Tags":[{"Key":"k","Value":"v"}]}`,
+			body2:       `{"Tags":[{"Value":"v","Key":"k"}], "Name":"tf-acc-test"}`,
+			expectEqual: true,
+		},
+		"JSON different": {
+			contentType: "application/json",
+			body1:       `{"Name":"tf-acc-test-1"}`,
+			body2:       `{"Name":"tf-acc-test-2"}`,
+		},
+		"JSON idempotency token": {
+			contentType: "application/x-amz-json-1.0",
+			body1:       `{"ClientToken":"b5a7e4c0-3c5e-4a4e-9e0a-0c2d7a3b1f11","Name":"tf-acc-test"}`,
+			body2:       `{"clientToken":"terraform-20241105123456789000000001","Name":"tf-acc-test"}`,
+			expectEqual: true,
+		},
+		"JSON unique ID and timestamp": {
+			contentType: "application/json; charset=utf-8",
+			body1:       `{"Name":"terraform-20241105123456789000000001","StartTime":"2024-11-05T12:34:56Z"}`,
+			body2:       `{"Name":"terraform-2024110612000000010000000a","StartTime":"2024-11-06T12:00:00.123+01:00"}`,
+			expectEqual: true,
+		},
+		"XML reordered": {
+			contentType: "application/xml",
+			body1:       `<Tagging><TagSet><Tag><Key>k</Key><Value>v</Value></Tag></TagSet></Tagging>`,
+			body2:       "<Tagging>\n  <TagSet>\n    <Tag><Value>v</Value><Key>k</Key></Tag>\n  </TagSet>\n</Tagging>",
+			expectEqual: true,
+		},
+		"XML different": {
+			contentType: "text/xml",
+			body1:       `<Tagging><TagSet><Tag><Key>k</Key><Value>v1</Value></Tag></TagSet></Tagging>`,
+			body2:       `<Tagging><TagSet><Tag><Key>k</Key><Value>v2</Value></Tag></TagSet></Tagging>`,
+		},
+		"XML idempotency token": {
+			contentType: "application/xml",
+			body1:       `<DistributionConfig><CallerReference>terraform-20241105123456789000000001</CallerReference><Enabled>true</Enabled></DistributionConfig>`,
+			body2:       `<DistributionConfig><Enabled>true</Enabled><CallerReference>other</CallerReference></DistributionConfig>`,
+			expectEqual: true,
+		},
+		"form reordered": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body1:       "Action=CreateTopic&Name=tf-acc-test&Version=2010-03-31",
+			body2:       "Version=2010-03-31&Name=tf-acc-test&Action=CreateTopic",
+			expectEqual: true,
+		},
+		"form idempotency token": {
+			contentType: "application/x-www-form-urlencoded",
+			body1:       "Action=RunInstances&ClientToken=abc&Foo.member.1.ClientToken=def",
+			body2:       "Action=RunInstances&ClientToken=xyz&Foo.member.1.ClientToken=uvw",
+			expectEqual: true,
+		},
+		"form different": {
+			contentType: "application/x-www-form-urlencoded",
+			body1:       "Action=CreateTopic&Name=tf-acc-test-1",
+			body2:       "Action=CreateTopic&Name=tf-acc-test-2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got1, err := acctest.VCRCanonicalBody(testCase.contentType, testCase.body1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got2, err := acctest.VCRCanonicalBody(testCase.contentType, testCase.body2)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := got1 == got2, testCase.expectEqual; got != want {
+				t.Errorf("%q == %q = %t, want %t", got1, got2, got, want)
+			}
+		})
+	}
+}
+
+func TestVCRCanonicalURL(t *testing.T) {
+	t.Parallel()
+
+	url1 := "https://ec2.us-west-2.amazonaws.com/?Version=2016-11-15&Action=DescribeVpcs&ClientToken=abc"
+	url2 := "https://ec2.us-west-2.amazonaws.com/?Action=DescribeVpcs&Version=2016-11-15&ClientToken=def"
+
+	if got1, got2 := acctest.VCRCanonicalURL(url1), acctest.VCRCanonicalURL(url2); got1 != got2 {
+		t.Errorf("%q != %q", got1, got2)
+	}
+
+	url3 := "https://s3.us-west-2.amazonaws.com/bucket-1/key"
+	url4 := "https://s3.us-west-2.amazonaws.com/bucket-2/key"
+
+	if got1, got2 := acctest.VCRCanonicalURL(url3), acctest.VCRCanonicalURL(url4); got1 == got2 {
+		t.Errorf("%q == %q", got1, got2)
+	}
+}
+
+func TestVCRScrubAccountID(t *testing.T) {
+	t.Parallel()
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body:    `{"RoleArn":"arn:aws:iam::111122223333:role/test"}`,
+			Form:    url.Values{"Owner": []string{"111122223333"}},
+			Headers: http.Header{"X-Test": []string{"111122223333"}},
+			URL:     "https://sqs.us-west-2.amazonaws.com/111122223333/test",
+		},
+		Response: cassette.Response{
+			Body:    `<Account>111122223333</Account>`,
+			Headers: http.Header{"X-Test": []string{"111122223333"}},
+		},
+	}
+
+	acctest.VCRScrubAccountID(i, "111122223333")
+
+	for _, v := range []string{
+		i.Request.Body,
+		i.Request.Form.Get("Owner"),
+		i.Request.Headers.Get("X-Test"),
+		i.Request.URL,
+		i.Response.Body,
+		i.Response.Headers.Get("X-Test"),
+	} {
+		if strings.Contains(v, "111122223333") {
+			t.Errorf("account ID not scrubbed: %s", v)
+		}
+		if !strings.Contains(v, "123456789012") {
+			t.Errorf("account ID not replaced: %s", v)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// APIAuditFileEnvVar is the name of the environment variable holding the path of the file that AWS API calls are audited to.
+	APIAuditFileEnvVar = "TF_AWS_API_AUDIT_FILE"
+)
+
+// apiAuditRecord is a single line of the API call audit log.
+// Request and response bodies are never recorded.
+type apiAuditRecord struct {
+	Time           time.Time `json:"time"`
+	Service        string    `json:"service"`
+	Operation      string    `json:"operation"`
+	Mutating       bool      `json:"mutating"`
+	Region         string    `json:"region,omitempty"`
+	ResourceType   string    `json:"resource_type,omitempty"`
+	ResourceName   string    `json:"resource_name,omitempty"`
+	IsDataSource   bool      `json:"is_data_source,omitempty"`
+	DurationMillis int64     `json:"duration_ms"`
+	Retries        int       `json:"retries"`
+	HTTPStatusCode int       `json:"http_status_code,omitempty"`
+	ErrorCode      string    `json:"error_code,omitempty"`
+	RequestID      string    `json:"request_id,omitempty"`
+}
+
+type apiAuditLogger struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+var (
+	apiAuditLoggersLock sync.Mutex
+	// apiAuditLoggers caches the audit logger for each file so that all provider configurations share a single writer.
+	apiAuditLoggers = make(map[string]*apiAuditLogger)
+)
+
+// newAPIAuditLogger returns the audit logger that appends to the specified file.
+// The file remains open for the lifetime of the provider process.
+func newAPIAuditLogger(path string) (*apiAuditLogger, error) {
+	apiAuditLoggersLock.Lock()
+	defer apiAuditLoggersLock.Unlock()
+
+	if v, ok := apiAuditLoggers[path]; ok {
+		return v, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening API audit file (%s): %w", path, err)
+	}
+
+	l := &apiAuditLogger{
+		w: f,
+	}
+	apiAuditLoggers[path] = l
+
+	return l, nil
+}
+
+func (l *apiAuditLogger) write(record apiAuditRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	_, err = l.w.Write(append(b, '\n'))
+
+	return err
+}
+
+// withAPIAuditLogger returns AWS SDK for Go v2 API options that write an audit record for each API call.
+// The record covers the whole operation invocation, including retries.
+func withAPIAuditLogger(l *apiAuditLogger) func(*middleware.Stack) error {
+	audit := middleware.InitializeMiddlewareFunc("TFAPIAudit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		operation := middleware.GetOperationName(ctx)
+		record := apiAuditRecord{
+			Time:           start.UTC(),
+			Service:        middleware.GetServiceID(ctx),
+			Operation:      operation,
+			Mutating:       isMutatingOperation(operation),
+			Region:         awsmiddleware.GetRegion(ctx),
+			DurationMillis: time.Since(start).Milliseconds(),
+		}
+
+		if v, ok := FromContext(ctx); ok {
+			record.ResourceType = v.TypeName
+			record.ResourceName = v.ResourceName
+			record.IsDataSource = v.IsDataSource
+		}
+
+		if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			record.Retries = len(v.Results) - 1
+		}
+
+		if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+			record.HTTPStatusCode = v.StatusCode
+		}
+
+		if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			record.RequestID = v
+		}
+
+		if err != nil {
+			if v, ok := errs.As[*awshttp.ResponseError](err); ok {
+				record.HTTPStatusCode = v.HTTPStatusCode()
+				record.RequestID = v.ServiceRequestID()
+			}
+
+			if v, ok := errs.As[smithy.APIError](err); ok {
+				record.ErrorCode = v.ErrorCode()
+			}
+		}
+
+		// Auditing must never fail the API call.
+		_ = l.write(record)
+
+		return out, metadata, err
+	})
+
+	return func(stack *middleware.Stack) error {
+		// Run after service metadata, e.g. Region, has been registered.
+		return stack.Initialize.Add(audit, middleware.After)
+	}
+}
+
+// isMutatingOperation returns whether the named API operation may change AWS resources.
+// Operations are considered read-only based on the AWS API naming conventions.
+func isMutatingOperation(operation string) bool {
+	for _, prefix := range []string{"BatchGet", "Describe", "Get", "Head", "List", "Lookup", "Search", "Select"} {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
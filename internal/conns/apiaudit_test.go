@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestWithAPIAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operation string
+		err       error
+		expected  apiAuditRecord
+	}{
+		"read-only": {
+			operation: "GetRole",
+			expected: apiAuditRecord{
+				Service:      "IAM",
+				Operation:    "GetRole",
+				ResourceType: "aws_iam_role",
+				ResourceName: "Role",
+			},
+		},
+		"mutating with error": {
+			operation: "PutRolePolicy",
+			err:       &smithy.GenericAPIError{Code: "MalformedPolicyDocument", Message: "contains secret"},
+			expected: apiAuditRecord{
+				Service:      "IAM",
+				Operation:    "PutRolePolicy",
+				Mutating:     true,
+				ResourceType: "aws_iam_role",
+				ResourceName: "Role",
+				ErrorCode:    "MalformedPolicyDocument",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			l := &apiAuditLogger{w: &buf}
+
+			stack := middleware.NewStack(testCase.operation, func() any { return nil })
+			if err := withAPIAuditLogger(l)(stack); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in any) (any, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, testCase.err
+			}), stack)
+
+			ctx := NewResourceContext(context.Background(), "iam", "Role", "aws_iam_role")
+			ctx = middleware.WithServiceID(ctx, "IAM")
+			ctx = middleware.WithOperationName(ctx, testCase.operation)
+			if _, _, err := handler.Handle(ctx, struct{ Secret string }{Secret: "s3cr3t"}); err != testCase.err {
+				t.Fatalf("handling: %s", err)
+			}
+
+			if bytes.Contains(buf.Bytes(), []byte("s3cr3t")) || bytes.Contains(buf.Bytes(), []byte("contains secret")) {
+				t.Errorf("audit record contains request or error body: %s", buf.String())
+			}
+
+			var got apiAuditRecord
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("unmarshaling audit record (%s): %s", buf.String(), err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreFields(apiAuditRecord{}, "Time", "DurationMillis")); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMutatingOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"BatchGetItem":             false,
+		"ChangeResourceRecordSets": true,
+		"CreateRole":               true,
+		"DeleteBucket":             true,
+		"DescribeInstances":        false,
+		"GetRole":                  false,
+		"HeadObject":               false,
+		"ListTagsForResource":      false,
+		"PutRolePolicy":            true,
+		"TagResource":              true,
+		"UpdateAssumeRolePolicy":   true,
+	}
+
+	for operation, expected := range testCases {
+		if got := isMutatingOperation(operation); got != expected {
+			t.Errorf("isMutatingOperation(%q) = %v, want %v", operation, got, expected)
+		}
+	}
+}
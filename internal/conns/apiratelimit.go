@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/smithy-go/middleware"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+// APIRateLimit limits the AWS API calls made by a service package.
+// If Operations is empty the limits apply to all of the service's operations.
+// All operations matched by a single APIRateLimit share its limits.
+type APIRateLimit struct {
+	MaxConcurrency    int
+	Operations        []string
+	RequestsPerSecond float64
+	Service           string
+}
+
+type apiRateLimiter struct {
+	limiter    *tfsync.RateLimiter
+	operations []string
+	semaphore  tfsync.Semaphore
+}
+
+func newAPIRateLimiter(v APIRateLimit) *apiRateLimiter {
+	l := &apiRateLimiter{
+		operations: v.Operations,
+	}
+
+	if v.MaxConcurrency > 0 {
+		l.semaphore = tfsync.NewSemaphore(v.MaxConcurrency)
+	}
+	if v.RequestsPerSecond > 0 {
+		l.limiter = tfsync.NewRateLimiter(v.RequestsPerSecond, 1)
+	}
+
+	return l
+}
+
+func (l *apiRateLimiter) matches(ctx context.Context) bool {
+	return len(l.operations) == 0 || slices.Contains(l.operations, middleware.GetOperationName(ctx))
+}
+
+// expandAPIRateLimiters returns the rate limiters for each service package.
+func expandAPIRateLimiters(limits []APIRateLimit) map[string][]*apiRateLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	m := make(map[string][]*apiRateLimiter)
+	for _, v := range limits {
+		m[v.Service] = append(m[v.Service], newAPIRateLimiter(v))
+	}
+
+	return m
+}
+
+// withAPIRateLimiters returns AWS SDK for Go v2 API options that apply the specified rate limiters.
+// Concurrency is limited per operation invocation, while requests per second are limited per attempt (including retries).
+func withAPIRateLimiters(limiters []*apiRateLimiter) func(*middleware.Stack) error {
+	concurrency := middleware.InitializeMiddlewareFunc("TFAPIConcurrencyLimit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		for _, l := range limiters {
+			if l.semaphore == nil || !l.matches(ctx) {
+				continue
+			}
+
+			if err := l.semaphore.Acquire(ctx); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+			defer l.semaphore.Release()
+		}
+
+		return next.HandleInitialize(ctx, in)
+	})
+	rate := middleware.FinalizeMiddlewareFunc("TFAPIRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		for _, l := range limiters {
+			if l.limiter == nil || !l.matches(ctx) {
+				continue
+			}
+
+			if err := l.limiter.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+		}
+
+		return next.HandleFinalize(ctx, in)
+	})
+
+	return func(stack *middleware.Stack) error {
+		if err := stack.Initialize.Add(concurrency, middleware.Before); err != nil {
+			return err
+		}
+
+		// Wait before each attempt is signed.
+		if err := stack.Finalize.Insert(rate, "Retry", middleware.After); err != nil {
+			return stack.Finalize.Add(rate, middleware.Before)
+		}
+
+		return nil
+	}
+}
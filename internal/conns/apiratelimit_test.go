@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestWithAPIRateLimitersMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operation string
+		expected  int32
+	}{
+		"matching operation": {
+			operation: "ChangeResourceRecordSets",
+			expected:  2,
+		},
+		"other operation": {
+			operation: "ListHostedZones",
+			expected:  8,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limiters := expandAPIRateLimiters([]APIRateLimit{{
+				MaxConcurrency: 2,
+				Operations:     []string{"ChangeResourceRecordSets"},
+				Service:        "route53",
+			}})["route53"]
+
+			var current, peak atomic.Int32
+			handler := middleware.HandlerFunc(func(ctx context.Context, in any) (any, middleware.Metadata, error) {
+				n := current.Add(1)
+				for {
+					if p := peak.Load(); n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				current.Add(-1)
+
+				return nil, middleware.Metadata{}, nil
+			})
+
+			var wg sync.WaitGroup
+			for range 8 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					stack := middleware.NewStack(testCase.operation, func() any { return nil })
+					if err := withAPIRateLimiters(limiters)(stack); err != nil {
+						t.Errorf("adding middleware: %s", err)
+						return
+					}
+
+					ctx := middleware.WithOperationName(context.Background(), testCase.operation)
+					if _, _, err := middleware.DecorateHandler(handler, stack).Handle(ctx, nil); err != nil {
+						t.Errorf("handling: %s", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got, want := peak.Load(), testCase.expected; got != want {
+				t.Errorf("peak concurrency = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestWithAPIRateLimitersRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	limiters := expandAPIRateLimiters([]APIRateLimit{{
+		RequestsPerSecond: 20,
+		Service:           "iam",
+	}})["iam"]
+
+	stack := middleware.NewStack("GetRole", func() any { return nil })
+	if err := withAPIRateLimiters(limiters)(stack); err != nil {
+		t.Fatalf("adding middleware: %s", err)
+	}
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, nil
+	}), stack)
+
+	ctx := middleware.WithOperationName(context.Background(), "GetRole")
+	start := time.Now()
+	for range 5 {
+		if _, _, err := handler.Handle(ctx, nil); err != nil {
+			t.Fatalf("handling: %s", err)
+		}
+	}
+
+	// The first request is allowed immediately, the remaining 4 at 50ms intervals.
+	if got, want := time.Since(start), 200*time.Millisecond; got < want-10*time.Millisecond {
+		t.Errorf("elapsed = %s, want at least %s", got, want)
+	}
+}
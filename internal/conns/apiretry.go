@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -27,3 +29,69 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// APIRetry configures the retries of the AWS API calls made by a service package.
+// Zero values leave the provider-wide retry configuration unchanged.
+type APIRetry struct {
+	ErrorCodes  []string
+	MaxAttempts int
+	MaxBackoff  time.Duration
+	Service     string
+}
+
+// expandAPIRetries returns the retry configuration for each service package.
+// Multiple configurations for the same service package are merged, with later values taking precedence.
+func expandAPIRetries(retries []APIRetry) map[string]APIRetry {
+	if len(retries) == 0 {
+		return nil
+	}
+
+	m := make(map[string]APIRetry)
+	for _, v := range retries {
+		apiRetry := m[v.Service]
+		apiRetry.Service = v.Service
+		apiRetry.ErrorCodes = append(apiRetry.ErrorCodes, v.ErrorCodes...)
+		if v.MaxAttempts > 0 {
+			apiRetry.MaxAttempts = v.MaxAttempts
+		}
+		if v.MaxBackoff > 0 {
+			apiRetry.MaxBackoff = v.MaxBackoff
+		}
+		m[v.Service] = apiRetry
+	}
+
+	return m
+}
+
+// withAPIRetry returns AWS SDK for Go v2 configuration that applies the specified retry configuration to Retryers created by the original configuration.
+func withAPIRetry(cfg aws.Config, v APIRetry) aws.Config {
+	newRetryer := cfg.Retryer
+
+	cfg.Retryer = func() aws.Retryer {
+		var retryer aws.Retryer
+		if newRetryer != nil {
+			retryer = newRetryer()
+		} else {
+			retryer = retry.NewStandard()
+		}
+
+		if len(v.ErrorCodes) > 0 {
+			retryer = retry.AddWithErrorCodes(retryer, v.ErrorCodes...)
+		}
+		if v.MaxAttempts > 0 {
+			retryer = retry.AddWithMaxAttempts(retryer, v.MaxAttempts)
+		}
+		if v.MaxBackoff > 0 {
+			retryer = retry.AddWithMaxBackoffDelay(retryer, v.MaxBackoff)
+		}
+
+		return retryer
+	}
+
+	// API clients wrap the Retryer with the configured maximum number of attempts.
+	if v.MaxAttempts > 0 {
+		cfg.RetryMaxAttempts = v.MaxAttempts
+	}
+
+	return cfg
+}
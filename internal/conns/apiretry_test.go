@@ -6,7 +6,9 @@ package conns
 import (
 	"errors"
 	"net/http"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
@@ -93,3 +95,93 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestWithAPIRetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		retry               APIRetry
+		err                 error
+		expectedRetryable   bool
+		expectedMaxAttempts int
+	}{
+		{
+			name:                "no configuration",
+			err:                 &smithy.GenericAPIError{Code: "MalformedPolicyDocument"},
+			expectedMaxAttempts: 25,
+		},
+		{
+			name: "error code",
+			retry: APIRetry{
+				ErrorCodes: []string{"MalformedPolicyDocument"},
+			},
+			err:                 &smithy.GenericAPIError{Code: "MalformedPolicyDocument"},
+			expectedRetryable:   true,
+			expectedMaxAttempts: 25,
+		},
+		{
+			name: "other error code",
+			retry: APIRetry{
+				ErrorCodes: []string{"MalformedPolicyDocument"},
+			},
+			err:                 &smithy.GenericAPIError{Code: "NoSuchEntity"},
+			expectedMaxAttempts: 25,
+		},
+		{
+			name: "max attempts",
+			retry: APIRetry{
+				MaxAttempts: 10,
+			},
+			err:                 errors.New(`this is not retryable`),
+			expectedMaxAttempts: 10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := aws.Config{
+				Retryer: func() aws.Retryer {
+					return retry.AddWithMaxAttempts(retry.NewStandard(), 25)
+				},
+			}
+			cfg = withAPIRetry(cfg, testCase.retry)
+			retryer := cfg.Retryer()
+
+			if got, want := retryer.IsErrorRetryable(testCase.err), testCase.expectedRetryable; got != want {
+				t.Errorf("IsErrorRetryable(%q) = %v, want %v", testCase.err, got, want)
+			}
+			if got, want := retryer.MaxAttempts(), testCase.expectedMaxAttempts; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestExpandAPIRetries(t *testing.T) {
+	t.Parallel()
+
+	got := expandAPIRetries([]APIRetry{
+		{ErrorCodes: []string{"MalformedPolicyDocument"}, MaxAttempts: 10, Service: "iam"},
+		{ErrorCodes: []string{"InvalidParameterValue"}, MaxBackoff: time.Minute, Service: "iam"},
+		{MaxAttempts: 5, Service: "ec2"},
+	})
+
+	if got, want := len(got), 2; got != want {
+		t.Fatalf("len = %d, want %d", got, want)
+	}
+	if got, want := got["iam"].ErrorCodes, []string{"MalformedPolicyDocument", "InvalidParameterValue"}; !slices.Equal(got, want) {
+		t.Errorf("ErrorCodes = %v, want %v", got, want)
+	}
+	if got, want := got["iam"].MaxAttempts, 10; got != want {
+		t.Errorf("MaxAttempts = %d, want %d", got, want)
+	}
+	if got, want := got["iam"].MaxBackoff, time.Minute; got != want {
+		t.Errorf("MaxBackoff = %s, want %s", got, want)
+	}
+	if got, want := got["ec2"].MaxAttempts, 5; got != want {
+		t.Errorf("MaxAttempts = %d, want %d", got, want)
+	}
+}
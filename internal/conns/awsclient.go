@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -26,12 +27,18 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	defaultTagsConfig *tftags.DefaultConfig
-	ignoreTagsConfig  *tftags.IgnoreConfig
-	Region            string
-	ServicePackages   map[string]ServicePackage
-
+	AccountID            string
+	costEstimationConfig *CostEstimationConfig
+	defaultTagsConfig    *tftags.DefaultConfig
+	ignoreTagsConfig     *tftags.IgnoreConfig
+	Region               string
+	ServicePackages      map[string]ServicePackage
+	tagDriftConfig       *tftags.DriftConfig
+	tagPolicyConfig      *tftags.PolicyConfig
+
+	apiRateLimiters           map[string][]*apiRateLimiter // From provider configuration.
+	apiRetries                map[string]APIRetry          // From provider configuration.
+	apiAuditLogger            *apiAuditLogger              // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	conns                     map[string]any
@@ -39,7 +46,11 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	override                  Override                // Per-resource override applied to this client.
+	overrides                 map[Override]*AWSClient // Cache of per-resource override clients.
+	overridesLock             sync.Mutex
 	partition                 endpoints.Partition
+	root                      *AWSClient // The provider-configured client, if this is an override client.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -55,6 +66,10 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
+func (c *AWSClient) CostEstimationConfig(context.Context) *CostEstimationConfig {
+	return c.costEstimationConfig
+}
+
 func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
 	return c.defaultTagsConfig
 }
@@ -63,6 +78,14 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+func (c *AWSClient) TagDriftConfig(context.Context) *tftags.DriftConfig {
+	return c.tagDriftConfig
+}
+
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -120,6 +143,12 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
+	if o, ok := OverrideFromContext(ctx); ok {
+		if v := errs.Must(c.WithOverride(ctx, o)); v != c {
+			return v.S3ExpressClient(ctx)
+		}
+	}
+
 	s3Client := c.S3Client(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
@@ -280,8 +309,23 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if v, ok := c.apiRateLimiters[servicePackageName]; ok {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clip(cfg.APIOptions), withAPIRateLimiters(v))
+		awsConfig = &cfg
+	}
+	if v, ok := c.apiRetries[servicePackageName]; ok {
+		cfg := withAPIRetry(awsConfig.Copy(), v)
+		awsConfig = &cfg
+	}
+	if c.apiAuditLogger != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clip(cfg.APIOptions), withAPIAuditLogger(c.apiAuditLogger))
+		awsConfig = &cfg
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -307,6 +351,16 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	// Any per-resource override selects a different (cached) AWSClient.
+	if o, ok := OverrideFromContext(ctx); ok {
+		v, err := c.WithOverride(ctx, o)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		c = v
+	}
+
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
@@ -26,16 +26,35 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+// DeferrableConfigAttributes are the provider configuration attributes that determine the AWS account, Region and endpoints used.
+// If the value of any of them is unknown, resources and data sources are deferred.
+var DeferrableConfigAttributes = []string{
+	"access_key",
+	"assume_role",
+	"assume_role_with_web_identity",
+	"emulator",
+	"endpoints",
+	"profile",
+	"region",
+	"secret_key",
+	"token",
+}
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIAuditFile                   string
+	APIRateLimits                  []APIRateLimit
+	APIRetries                     []APIRetry
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CostEstimationConfig           *CostEstimationConfig
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	Emulator                       *EmulatorConfig
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
@@ -57,6 +76,8 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagDriftConfig                 *tftags.DriftConfig
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -70,6 +91,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	c.configureEmulator()
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -190,6 +213,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
+	if accountID == "" && c.Emulator != nil {
+		accountID = c.emulatorAccountID()
+	}
+
 	if accountID == "" {
 		diags = append(diags, errs.NewWarningDiagnostic(
 			"AWS account ID not found for provider",
@@ -207,9 +234,22 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	if c.APIAuditFile != "" {
+		l, err := newAPIAuditLogger(c.APIAuditFile)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.apiAuditLogger = l
+	}
+
 	client.AccountID = accountID
+	client.apiRateLimiters = expandAPIRateLimiters(c.APIRateLimits)
+	client.apiRetries = expandAPIRetries(c.APIRetries)
+	client.costEstimationConfig = c.CostEstimationConfig
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.tagDriftConfig = c.TagDriftConfig
+	client.tagPolicyConfig = c.TagPolicyConfig
 	client.Region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
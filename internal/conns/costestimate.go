@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+// CostEstimationConfig is the provider's cost_estimation configuration.
+type CostEstimationConfig struct {
+	// MonthlyThreshold is the estimated monthly cost, in USD, at or above which a warning is reported.
+	MonthlyThreshold float64
+}
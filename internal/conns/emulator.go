@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// DefaultEmulatorAccountID is the AWS account ID used with a local AWS emulator if none is configured.
+	DefaultEmulatorAccountID = "000000000000"
+
+	// emulatorAccessKey and emulatorSecretKey are the static credentials used with a local AWS emulator if none are configured.
+	emulatorAccessKey = "test"
+	emulatorSecretKey = "test"
+)
+
+// EmulatorConfig is the provider's emulator configuration.
+type EmulatorConfig struct {
+	AccountID string
+	Endpoint  string
+}
+
+// configureEmulator configures the provider to run offline against a local AWS emulator.
+// Every service endpoint not explicitly configured is set to the emulator's endpoint and
+// calls to the EC2 metadata service and STS are skipped.
+// Credentials and Region default to placeholder values unless configured, including via the environment.
+func (c *Config) configureEmulator() {
+	if c.Emulator == nil {
+		return
+	}
+
+	serviceEndpoints := make(map[string]string)
+	for _, pkg := range names.ProviderPackages() {
+		serviceEndpoints[pkg] = c.Emulator.Endpoint
+	}
+	for pkg, endpoint := range c.Endpoints {
+		if endpoint != "" {
+			serviceEndpoints[pkg] = endpoint
+		}
+	}
+	c.Endpoints = serviceEndpoints
+
+	c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+
+	if c.AccessKey == "" && c.Profile == "" && os.Getenv("AWS_ACCESS_KEY_ID") == "" && os.Getenv("AWS_PROFILE") == "" {
+		c.AccessKey = emulatorAccessKey
+		c.SecretKey = emulatorSecretKey
+	}
+
+	if c.Region == "" && os.Getenv("AWS_REGION") == "" && os.Getenv("AWS_DEFAULT_REGION") == "" {
+		c.Region = endpoints.UsEast1RegionID
+	}
+}
+
+// emulatorAccountID returns the AWS account ID to use with a local AWS emulator.
+func (c *Config) emulatorAccountID() string {
+	if c.Emulator.AccountID != "" {
+		return c.Emulator.AccountID
+	}
+
+	return DefaultEmulatorAccountID
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigureEmulator(t *testing.T) {
+	const endpoint = "http://localhost:4566"
+
+	testCases := map[string]struct {
+		config               Config
+		environmentVariables map[string]string
+		expectedAccessKey    string
+		expectedRegion       string
+	}{
+		"defaults": {
+			config:            Config{},
+			expectedAccessKey: emulatorAccessKey,
+			expectedRegion:    "us-east-1",
+		},
+		"configured": {
+			config: Config{
+				AccessKey: "AKIAEXAMPLE",
+				Region:    "eu-west-1",
+			},
+			expectedAccessKey: "AKIAEXAMPLE",
+			expectedRegion:    "eu-west-1",
+		},
+		"environment": {
+			config: Config{},
+			environmentVariables: map[string]string{
+				"AWS_PROFILE": "emulator",
+				"AWS_REGION":  "eu-west-1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range []string{"AWS_ACCESS_KEY_ID", "AWS_DEFAULT_REGION", "AWS_PROFILE", "AWS_REGION"} {
+				t.Setenv(k, testCase.environmentVariables[k])
+			}
+
+			c := testCase.config
+			c.Emulator = &EmulatorConfig{Endpoint: endpoint}
+			c.Endpoints = map[string]string{
+				names.S3: "http://localhost:9000",
+			}
+
+			c.configureEmulator()
+
+			if got, want := c.Endpoints[names.S3], "http://localhost:9000"; got != want {
+				t.Errorf("Endpoints[%q] = %q, want %q", names.S3, got, want)
+			}
+			for _, pkg := range []string{names.EC2, names.IAM, names.STS} {
+				if got, want := c.Endpoints[pkg], endpoint; got != want {
+					t.Errorf("Endpoints[%q] = %q, want %q", pkg, got, want)
+				}
+			}
+			if got, want := len(c.Endpoints), len(names.ProviderPackages()); got != want {
+				t.Errorf("len(Endpoints) = %d, want %d", got, want)
+			}
+
+			if got, want := c.EC2MetadataServiceEnableState, imds.ClientDisabled; got != want {
+				t.Errorf("EC2MetadataServiceEnableState = %v, want %v", got, want)
+			}
+			if !c.S3UsePathStyle || !c.SkipCredsValidation || !c.SkipRegionValidation || !c.SkipRequestingAccountId {
+				t.Errorf("S3UsePathStyle, SkipCredsValidation, SkipRegionValidation and SkipRequestingAccountId must all be set")
+			}
+
+			if got, want := c.AccessKey, testCase.expectedAccessKey; got != want {
+				t.Errorf("AccessKey = %q, want %q", got, want)
+			}
+			if got, want := c.Region, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigureEmulatorNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	c := Config{}
+	c.configureEmulator()
+
+	if c.Endpoints != nil || c.SkipCredsValidation || c.AccessKey != "" || c.Region != "" {
+		t.Errorf("Config changed: %+v", c)
+	}
+}
+
+func TestEmulatorAccountID(t *testing.T) {
+	t.Parallel()
+
+	c := Config{Emulator: &EmulatorConfig{}}
+	if got, want := c.emulatorAccountID(), DefaultEmulatorAccountID; got != want {
+		t.Errorf("emulatorAccountID() = %q, want %q", got, want)
+	}
+
+	c.Emulator.AccountID = "123456789012"
+	if got, want := c.emulatorAccountID(), "123456789012"; got != want {
+		t.Errorf("emulatorAccountID() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Override represents a per-resource override of the provider's AWS Region or IAM role.
+type Override struct {
+	AssumeRoleARN string
+	Region        string
+}
+
+// IsEmpty returns whether the override changes nothing.
+func (o Override) IsEmpty() bool {
+	return o.AssumeRoleARN == "" && o.Region == ""
+}
+
+type overrideContextKeyType int
+
+var overrideContextKey overrideContextKeyType
+
+// NewOverrideContext returns a Context that carries a per-resource override.
+// API clients obtained using the returned Context honor the override.
+func NewOverrideContext(ctx context.Context, o Override) context.Context {
+	if o.IsEmpty() {
+		return ctx
+	}
+
+	return context.WithValue(ctx, overrideContextKey, o)
+}
+
+// OverrideFromContext returns any per-resource override carried in Context.
+func OverrideFromContext(ctx context.Context) (Override, bool) {
+	o, ok := ctx.Value(overrideContextKey).(Override)
+	return o, ok
+}
+
+// WithOverride returns the provider Meta (instance data) for the specified override.
+// The returned AWSClient, including its API clients, is cached by AWS Region and IAM role.
+func (c *AWSClient) WithOverride(ctx context.Context, o Override) (*AWSClient, error) {
+	root := c
+	if c.root != nil {
+		root = c.root
+	}
+
+	if o.Region == root.Region {
+		o.Region = ""
+	}
+	if o.IsEmpty() {
+		return root, nil
+	}
+	if o == c.override {
+		return c, nil
+	}
+
+	root.overridesLock.Lock()
+	defer root.overridesLock.Unlock()
+
+	if v, ok := root.overrides[o]; ok {
+		return v, nil
+	}
+
+	client, err := root.newOverrideClient(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if root.overrides == nil {
+		root.overrides = make(map[Override]*AWSClient)
+	}
+	root.overrides[o] = client
+
+	return client, nil
+}
+
+// newOverrideClient returns a copy of the AWSClient with the specified override applied.
+func (c *AWSClient) newOverrideClient(ctx context.Context, o Override) (*AWSClient, error) {
+	cfg := c.awsConfig.Copy()
+	accountID := c.AccountID
+	region := c.Region
+
+	if o.Region != "" {
+		cfg.Region = o.Region
+		region = o.Region
+	}
+
+	if o.AssumeRoleARN != "" {
+		roleARN, err := arn.Parse(o.AssumeRoleARN)
+		if err != nil {
+			return nil, fmt.Errorf("parsing IAM role ARN (%s): %w", o.AssumeRoleARN, err)
+		}
+		accountID = roleARN.AccountID
+
+		stsClient := sts.NewFromConfig(c.awsConfig.Copy(), func(opts *sts.Options) {
+			if v := c.endpoints[names.STS]; v != "" {
+				opts.BaseEndpoint = aws.String(v)
+			}
+			if c.stsRegion != "" {
+				opts.Region = c.stsRegion
+			}
+		})
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, o.AssumeRoleARN, func(opts *stscreds.AssumeRoleOptions) {
+			opts.RoleSessionName = sdkid.UniqueId()
+		}))
+	}
+
+	tflog.Debug(ctx, "Creating provider Meta override", map[string]any{
+		"tf_aws.override.assume_role_arn": o.AssumeRoleARN,
+		"tf_aws.override.region":          o.Region,
+	})
+
+	return &AWSClient{
+		AccountID:            accountID,
+		costEstimationConfig: c.costEstimationConfig,
+		defaultTagsConfig:    c.defaultTagsConfig,
+		ignoreTagsConfig:     c.ignoreTagsConfig,
+		Region:               region,
+		ServicePackages:      c.ServicePackages,
+		tagDriftConfig:       c.tagDriftConfig,
+		tagPolicyConfig:      c.tagPolicyConfig,
+
+		apiRateLimiters:           c.apiRateLimiters,
+		apiRetries:                c.apiRetries,
+		apiAuditLogger:            c.apiAuditLogger,
+		awsConfig:                 &cfg,
+		clients:                   make(map[string]any, 0),
+		conns:                     make(map[string]any, 0),
+		endpoints:                 c.endpoints,
+		httpClient:                c.httpClient,
+		logger:                    c.logger,
+		override:                  o,
+		partition:                 c.partition,
+		root:                      c,
+		session:                   c.session,
+		s3UsePathStyle:            c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint: c.s3USEast1RegionalEndpoint,
+		stsRegion:                 c.stsRegion,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestAWSClientWithOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	root := &AWSClient{
+		AccountID: "123456789012",
+		Region:    "us-west-2",                      //lintignore:AWSAT003
+		awsConfig: &aws.Config{Region: "us-west-2"}, //lintignore:AWSAT003
+	}
+
+	// Overriding the provider's own region is a no-op.
+	if got, err := root.WithOverride(ctx, Override{Region: "us-west-2"}); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	} else if got != root {
+		t.Errorf("same region: got override client, want provider client")
+	}
+
+	east, err := root.WithOverride(ctx, Override{Region: "us-east-1"}) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := east.Region, "us-east-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+	if got, want := east.awsConfig.Region, "us-east-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("aws.Config Region = %s, want %s", got, want)
+	}
+	if got, want := root.awsConfig.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("provider aws.Config Region = %s, want %s", got, want)
+	}
+
+	// Override clients are cached.
+	if got, _ := root.WithOverride(ctx, Override{Region: "us-east-1"}); got != east { //lintignore:AWSAT003
+		t.Errorf("expected cached override client")
+	}
+	if got, _ := east.WithOverride(ctx, Override{Region: "us-east-1"}); got != east { //lintignore:AWSAT003
+		t.Errorf("expected override client to return itself")
+	}
+	if got, _ := east.WithOverride(ctx, Override{}); got != root {
+		t.Errorf("expected empty override to return provider client")
+	}
+
+	role, err := root.WithOverride(ctx, Override{AssumeRoleARN: "arn:aws:iam::210987654321:role/test"}) //lintignore:AWSAT005
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := role.AccountID, "210987654321"; got != want {
+		t.Errorf("AccountID = %s, want %s", got, want)
+	}
+	if got, want := role.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+
+	if _, err := root.WithOverride(ctx, Override{AssumeRoleARN: "not-an-arn"}); err == nil {
+		t.Errorf("expected error for invalid role ARN")
+	}
+}
+
+func TestOverrideContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	if _, ok := OverrideFromContext(NewOverrideContext(ctx, Override{})); ok {
+		t.Errorf("empty override should not be placed in Context")
+	}
+
+	want := Override{Region: "eu-west-1"} //lintignore:AWSAT003
+	if got, ok := OverrideFromContext(NewOverrideContext(ctx, want)); !ok || got != want {
+		t.Errorf("OverrideFromContext = %v, %t, want %v, true", got, ok, want)
+	}
+}
@@ -84,6 +84,23 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to review and filter the resources deleted by sweepers
+const (
+	// If set to a truthy value, sweepers report the resources they would delete without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Path of a file to which a report of swept resources is appended.
+	// The report is written as CSV if the file name ends in ".csv" and as JSON Lines otherwise.
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// Comma-separated key=value tags that a resource must have in order to be swept.
+	// A key without a value matches any value.
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+
+	// Minimum age, as a duration (e.g. "24h"), of a resource in order to be swept
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -186,6 +186,36 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Waves returns the nodes of the dependency graph grouped into processing waves.
+// Each node appears in a later wave than all of its dependencies, so the nodes in a wave can be processed concurrently.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Waves() ([][]string, error) {
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	waves := make([][]string, 0)
+	levels := make(map[string]int, len(order))
+
+	// OverallOrder places every node after its dependencies.
+	for _, node := range order {
+		level := 0
+		for _, dependency := range g.outgoingEdges[node] {
+			level = max(level, levels[dependency]+1)
+		}
+		levels[node] = level
+
+		if level == len(waves) {
+			waves = append(waves, make([]string, 0))
+		}
+		waves[level] = append(waves[level], node)
+	}
+
+	return waves, nil
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
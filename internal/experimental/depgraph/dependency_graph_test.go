@@ -417,3 +417,60 @@ func TestDependencyGraphOverallOrder(t *testing.T) {
 		t.Fatalf("incorrect overall order. Expected: %v, got: %v", expected, got)
 	}
 }
+
+func TestDependencyGraphWaves(t *testing.T) {
+	t.Parallel()
+
+	g := New()
+
+	got, err := g.Waves()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := [][]string{}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect waves. Expected: %v, got: %v", expected, got)
+	}
+
+	// ENIs, then subnets and security groups, then the VPC.
+	g.AddNode("vpc")
+	g.AddNode("subnet_1")
+	g.AddNode("subnet_2")
+	g.AddNode("sg")
+	g.AddNode("eni_1")
+	g.AddNode("eni_2")
+	g.AddNode("bucket")
+
+	for _, v := range [][2]string{
+		{"vpc", "subnet_1"},
+		{"vpc", "subnet_2"},
+		{"vpc", "sg"},
+		{"subnet_1", "eni_1"},
+		{"subnet_2", "eni_2"},
+		{"sg", "eni_1"},
+		{"sg", "eni_2"},
+	} {
+		err = g.AddDependency(v[0], v[1])
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	got, err = g.Waves()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := [][]string{{"eni_1", "eni_2", "bucket"}, {"subnet_1", "subnet_2", "sg"}, {"vpc"}}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect waves. Expected: %v, got: %v", expected, got)
+	}
+
+	// Dependency cycle.
+	err = g.AddDependency("eni_1", "vpc")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = g.Waves()
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
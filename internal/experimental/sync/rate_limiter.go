@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket rate limiter.
+// Tokens are added at a fixed rate up to a maximum burst size, and each call to Wait consumes one token.
+type RateLimiter struct {
+	burst  float64
+	last   time.Time
+	lock   sync.Mutex
+	now    func() time.Time
+	rate   float64 // Tokens per second.
+	tokens float64
+}
+
+// NewRateLimiter returns a rate limiter that allows events at the specified rate (per second) with the specified burst size.
+// A burst size of less than one is treated as one.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return newRateLimiter(rate, burst, time.Now)
+}
+
+func newRateLimiter(rate float64, burst int, now func() time.Time) *RateLimiter {
+	b := float64(max(burst, 1))
+
+	return &RateLimiter{
+		burst:  b,
+		last:   now(),
+		now:    now,
+		rate:   rate,
+		tokens: b,
+	}
+}
+
+// Wait blocks until a token is available or the context is done, whichever happens first.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return nil
+	}
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve consumes a token and returns how long the caller must wait before acting on it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *RateLimiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
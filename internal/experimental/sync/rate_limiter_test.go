@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	l := newRateLimiter(2, 2, func() time.Time { return now })
+
+	for i, want := range []time.Duration{0, 0, 500 * time.Millisecond, time.Second} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reservation %d: got %s, want %s", i, got, want)
+		}
+	}
+
+	// Refill the bucket.
+	now = now.Add(10 * time.Second)
+
+	if got, want := l.reserve(), time.Duration(0); got != want {
+		t.Errorf("after refill: got %s, want %s", got, want)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := NewRateLimiter(0.001, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("first wait: %s", err)
+	}
+
+	cancel()
+
+	if err := l.Wait(ctx); err == nil {
+		t.Fatal("expected error waiting with canceled context")
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	var l *RateLimiter
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("nil limiter: %s", err)
+	}
+}
+
+func TestSemaphoreAcquire(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphore(1)
+	ctx := context.Background()
+
+	if err := s.Acquire(ctx); err != nil {
+		t.Fatalf("first acquire: %s", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := s.Acquire(ctx2); err == nil {
+		t.Fatal("expected error acquiring full semaphore")
+	}
+
+	s.Release()
+
+	if err := s.Acquire(ctx); err != nil {
+		t.Fatalf("acquire after release: %s", err)
+	}
+}
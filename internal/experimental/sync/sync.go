@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"sync"
@@ -15,6 +16,11 @@ import (
 // This can be used to work with resources with low quotas.
 type Semaphore chan struct{}
 
+// NewSemaphore returns an unnamed semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
 var semaphoreKV = &struct {
 	lock  sync.Locker
 	store map[string]Semaphore
@@ -51,6 +57,21 @@ func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
+// Acquire waits for a semaphore or for the context to be done, whichever happens first.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release releases a semaphore acquired with Acquire.
+func (s Semaphore) Release() {
+	s.Notify()
+}
+
 // Notify releases a semaphore
 // NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// WriteOnlyConfigReader is implemented by *schema.ResourceData.
+type WriteOnlyConfigReader interface {
+	GetRawConfigAt(path cty.Path) (cty.Value, diag.Diagnostics)
+}
+
+// GetWriteOnlyStringValue returns the configured value of the write-only string attribute at the specified path.
+// Write-only values are never persisted to plan or state, so they are read from the raw configuration.
+// An empty string is returned if the attribute is not configured.
+func GetWriteOnlyStringValue(d WriteOnlyConfigReader, path cty.Path) (string, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !v.Type().Equals(cty.String) {
+		return "", append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid write-only attribute type",
+			Detail:        "Expected a string value, got: " + v.Type().FriendlyName(),
+			AttributePath: path,
+		})
+	}
+
+	if v.IsNull() || !v.IsKnown() {
+		return "", diags
+	}
+
+	return v.AsString(), diags
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type rawConfig cty.Value
+
+func (c rawConfig) GetRawConfigAt(path cty.Path) (cty.Value, diag.Diagnostics) {
+	v, err := path.Apply(cty.Value(c))
+	if err != nil {
+		return cty.DynamicVal, diag.FromErr(err)
+	}
+
+	return v, nil
+}
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("password_wo")
+
+	testCases := map[string]struct {
+		config    cty.Value
+		expected  string
+		expectErr bool
+	}{
+		"configured": {
+			config:   cty.ObjectVal(map[string]cty.Value{"password_wo": cty.StringVal("s3cr3t")}),
+			expected: "s3cr3t",
+		},
+		"null": {
+			config: cty.ObjectVal(map[string]cty.Value{"password_wo": cty.NullVal(cty.String)}),
+		},
+		"unknown": {
+			config: cty.ObjectVal(map[string]cty.Value{"password_wo": cty.UnknownVal(cty.String)}),
+		},
+		"wrong type": {
+			config:    cty.ObjectVal(map[string]cty.Value{"password_wo": cty.NumberIntVal(1)}),
+			expectErr: true,
+		},
+		"missing": {
+			config:    cty.EmptyObjectVal,
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := GetWriteOnlyStringValue(rawConfig(testCase.config), path)
+
+			if got, want := diags.HasError(), testCase.expectErr; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, diags)
+			}
+			if got != testCase.expected {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
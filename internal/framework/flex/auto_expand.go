@@ -99,6 +99,13 @@ func autoExpandConvert(ctx context.Context, from, to any, flexer autoFlexer) dia
 			diags.Append(autoFlexConvertStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
 			return diags
 		}
+
+		// Top-level struct to union conversion.
+		if typFrom, typTo := valFrom.Type(), valTo.Type(); typFrom.Kind() == reflect.Struct && typTo.Kind() == reflect.Interface && len(unionFields(typFrom)) > 0 {
+			tflog.SubsystemInfo(ctx, subsystemName, "Converting")
+			diags.Append(autoFlexConvertStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
+			return diags
+		}
 	}
 
 	// Anything else.
@@ -5997,6 +5997,214 @@ func TestExpandTypedExpander(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var targetInterface awsUnionInterface
+
+	testCases := autoFlexTestCases{
+		"top level string member": {
+			Source: tfUnion{
+				String: types.StringValue("value1"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			Target:     &targetInterface,
+			WantTarget: testFlexAWSUnionInterfacePtr(&awsUnionInterfaceMemberString{Value: "value1"}),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnionInterface]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnionInterface]()),
+				infoTargetIsRegisteredUnion("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("", "String", reflect.TypeFor[tfUnion](), "", "Value", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("String", reflect.TypeFor[types.String](), "Value", reflect.TypeFor[string]()),
+			},
+		},
+		"single list Source and single union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberStruct{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoTargetIsRegisteredUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[0]", "Nested", reflect.TypeFor[tfUnion](), "Field1", "Value", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Nested[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Nested[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"list Source and union slice Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						String: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnionInterface{
+					&awsUnionInterfaceMemberString{Value: "value1"},
+					&awsUnionInterfaceMemberStruct{Value: awsSingleStringValue{Field1: "value2"}},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnionInterface]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnionInterface]()),
+				infoTargetIsRegisteredUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[0]", "String", reflect.TypeFor[tfUnion](), "Field1[0]", "Value", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].String", reflect.TypeFor[types.String](), "Field1[0].Value", reflect.TypeFor[string]()),
+				infoTargetIsRegisteredUnion("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnionInterface]()),
+				traceMatchedUnionMember("Field1[1]", "Nested", reflect.TypeFor[tfUnion](), "Field1[1]", "Value", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[1].Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1[1].Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[1].Nested[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1[1].Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[1].Nested[0].Field1", reflect.TypeFor[types.String](), "Field1[1].Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"no member set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoTargetIsRegisteredUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+				traceNoUnionMemberSet("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+			},
+		},
+		"multiple members set": {
+			Source: tfUnion{
+				String: types.StringValue("value1"),
+				Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{
+						Field1: types.StringValue("value2"),
+					},
+				}),
+			},
+			Target: &targetInterface,
+			expectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), []string{"string", "nested"}),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnionInterface]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnionInterface]()),
+				infoTargetIsRegisteredUnion("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[*awsUnionInterface]()),
+				errorMultipleUnionMembersSet("", reflect.TypeFor[tfUnion](), []any{"string", "nested"}, "", reflect.TypeFor[*awsUnionInterface]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandRegisteredModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level": {
+			Source: &tfRegisteredModel{
+				Name:      types.StringValue("value1"),
+				StartTime: timetypes.NewRFC3339ValueMust("2024-01-02T03:04:05Z"),
+				Values:    fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			},
+			Target: &awsRegisteredModel{},
+			WantTarget: &awsRegisteredModel{
+				DisplayName: aws.String("value1"),
+				StartTime:   1704164645,
+				Values:      aws.String("a,b"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfRegisteredModel](), reflect.TypeFor[*awsRegisteredModel]()),
+				infoConverting(reflect.TypeFor[tfRegisteredModel](), reflect.TypeFor[*awsRegisteredModel]()),
+				traceMatchedFields("Name", reflect.TypeFor[tfRegisteredModel](), "DisplayName", reflect.TypeFor[*awsRegisteredModel]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[types.String](), "DisplayName", reflect.TypeFor[*string]()),
+				traceMatchedFields("StartTime", reflect.TypeFor[tfRegisteredModel](), "StartTime", reflect.TypeFor[*awsRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("", "StartTime", reflect.TypeFor[tfRegisteredModel](), "", "StartTime", reflect.TypeFor[*awsRegisteredModel]()),
+				traceMatchedFields("Values", reflect.TypeFor[tfRegisteredModel](), "Values", reflect.TypeFor[*awsRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("", "Values", reflect.TypeFor[tfRegisteredModel](), "", "Values", reflect.TypeFor[*awsRegisteredModel]()),
+			},
+		},
+		"nested": {
+			Source: &tfListNestedObject[tfRegisteredModel]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfRegisteredModel{
+					Name:      types.StringValue("value1"),
+					StartTime: timetypes.NewRFC3339Null(),
+					Values:    fwtypes.NewListValueOfNull[types.String](ctx),
+				}),
+			},
+			Target: &awsRegisteredModelSingle{},
+			WantTarget: &awsRegisteredModelSingle{
+				Field1: &awsRegisteredModel{
+					DisplayName: aws.String("value1"),
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfListNestedObject[tfRegisteredModel]](), reflect.TypeFor[*awsRegisteredModelSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfRegisteredModel]](), reflect.TypeFor[*awsRegisteredModelSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfRegisteredModel]](), "Field1", reflect.TypeFor[*awsRegisteredModelSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfRegisteredModel]](), "Field1", reflect.TypeFor[*awsRegisteredModel]()),
+				traceMatchedFieldsWithPath("Field1[0]", "Name", reflect.TypeFor[tfRegisteredModel](), "Field1", "DisplayName", reflect.TypeFor[*awsRegisteredModel]()),
+				infoConvertingWithPath("Field1[0].Name", reflect.TypeFor[types.String](), "Field1.DisplayName", reflect.TypeFor[*string]()),
+				traceMatchedFieldsWithPath("Field1[0]", "StartTime", reflect.TypeFor[tfRegisteredModel](), "Field1", "StartTime", reflect.TypeFor[*awsRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("Field1[0]", "StartTime", reflect.TypeFor[tfRegisteredModel](), "Field1", "StartTime", reflect.TypeFor[*awsRegisteredModel]()),
+				traceMatchedFieldsWithPath("Field1[0]", "Values", reflect.TypeFor[tfRegisteredModel](), "Field1", "Values", reflect.TypeFor[*awsRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("Field1[0]", "Values", reflect.TypeFor[tfRegisteredModel](), "Field1", "Values", reflect.TypeFor[*awsRegisteredModel]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func testFlexAWSUnionInterfacePtr(v awsUnionInterface) *awsUnionInterface { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
+
 type autoFlexTestCase struct {
 	Options          []AutoFlexOptionsFunc
 	Source           any
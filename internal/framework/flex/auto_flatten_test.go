@@ -4802,6 +4802,184 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level string member": {
+			Source: &awsUnionInterfaceMemberString{Value: "value1"},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				String: types.StringValue("value1"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionInterfaceMemberString](), reflect.TypeFor[*tfUnion]()),
+				infoConverting(reflect.TypeFor[awsUnionInterfaceMemberString](), reflect.TypeFor[*tfUnion]()),
+				infoSourceIsRegisteredUnionMember("", reflect.TypeFor[awsUnionInterfaceMemberString](), "", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("", "Value", reflect.TypeFor[awsUnionInterfaceMemberString](), "", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Value", reflect.TypeFor[string](), "String", reflect.TypeFor[types.String]()),
+			},
+		},
+		"single union Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionInterfaceMemberStruct{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsRegisteredUnionMember("Field1", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1", "Value", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1", "Nested", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Nested.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"nil union Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"union slice Source and list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnionInterface{
+					&awsUnionInterfaceMemberString{Value: "value1"},
+					&awsUnionInterfaceMemberStruct{Value: awsSingleStringValue{Field1: "value2"}},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						String: types.StringValue("value1"),
+						Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						String: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnionInterface](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsRegisteredUnionMember("Field1[0]", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Value", reflect.TypeFor[awsUnionInterfaceMemberString](), "Field1[0]", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[string](), "Field1[0].String", reflect.TypeFor[types.String]()),
+				infoSourceIsRegisteredUnionMember("Field1[1]", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[1]", "Value", reflect.TypeFor[awsUnionInterfaceMemberStruct](), "Field1[1]", "Nested", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[1].Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[1].Nested", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[1].Value.Field1", reflect.TypeFor[string](), "Field1[1].Nested.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenRegisteredModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level": {
+			Source: &awsRegisteredModel{
+				DisplayName: aws.String("value1"),
+				Name:        aws.String("value2"),
+				StartTime:   1704164645,
+				Values:      aws.String("a,b"),
+			},
+			Target: &tfRegisteredModel{},
+			WantTarget: &tfRegisteredModel{
+				Name:      types.StringValue("value1"),
+				StartTime: timetypes.NewRFC3339ValueMust("2024-01-02T03:04:05Z"),
+				Values:    fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsRegisteredModel](), reflect.TypeFor[*tfRegisteredModel]()),
+				infoConverting(reflect.TypeFor[awsRegisteredModel](), reflect.TypeFor[*tfRegisteredModel]()),
+				traceMatchedFields("DisplayName", reflect.TypeFor[awsRegisteredModel](), "Name", reflect.TypeFor[*tfRegisteredModel]()),
+				infoConvertingWithPath("DisplayName", reflect.TypeFor[*string](), "Name", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsRegisteredModel](), "Name", reflect.TypeFor[*tfRegisteredModel]()),
+				traceMatchedFields("StartTime", reflect.TypeFor[awsRegisteredModel](), "StartTime", reflect.TypeFor[*tfRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("", "StartTime", reflect.TypeFor[awsRegisteredModel](), "", "StartTime", reflect.TypeFor[*tfRegisteredModel]()),
+				traceMatchedFields("Values", reflect.TypeFor[awsRegisteredModel](), "Values", reflect.TypeFor[*tfRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("", "Values", reflect.TypeFor[awsRegisteredModel](), "", "Values", reflect.TypeFor[*tfRegisteredModel]()),
+			},
+		},
+		"nested": {
+			Source: &awsRegisteredModelSingle{
+				Field1: &awsRegisteredModel{
+					DisplayName: aws.String("value1"),
+				},
+			},
+			Target: &tfListNestedObject[tfRegisteredModel]{},
+			WantTarget: &tfListNestedObject[tfRegisteredModel]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfRegisteredModel{
+					Name:      types.StringValue("value1"),
+					StartTime: timetypes.NewRFC3339TimeValue(time.Unix(0, 0).UTC()),
+					Values:    fwtypes.NewListValueOfNull[types.String](ctx),
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsRegisteredModelSingle](), reflect.TypeFor[*tfListNestedObject[tfRegisteredModel]]()),
+				infoConverting(reflect.TypeFor[awsRegisteredModelSingle](), reflect.TypeFor[*tfListNestedObject[tfRegisteredModel]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsRegisteredModelSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfRegisteredModel]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*awsRegisteredModel](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfRegisteredModel]]()),
+				traceMatchedFieldsWithPath("Field1", "DisplayName", reflect.TypeFor[awsRegisteredModel](), "Field1", "Name", reflect.TypeFor[*tfRegisteredModel]()),
+				infoConvertingWithPath("Field1.DisplayName", reflect.TypeFor[*string](), "Field1.Name", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingFieldWithPath("Field1", reflect.TypeFor[awsRegisteredModel](), "Name", "Field1", reflect.TypeFor[*tfRegisteredModel]()),
+				traceMatchedFieldsWithPath("Field1", "StartTime", reflect.TypeFor[awsRegisteredModel](), "Field1", "StartTime", reflect.TypeFor[*tfRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("Field1", "StartTime", reflect.TypeFor[awsRegisteredModel](), "Field1", "StartTime", reflect.TypeFor[*tfRegisteredModel]()),
+				traceMatchedFieldsWithPath("Field1", "Values", reflect.TypeFor[awsRegisteredModel](), "Field1", "Values", reflect.TypeFor[*tfRegisteredModel]()),
+				traceUsingRegisteredFieldConverter("Field1", "Values", reflect.TypeFor[awsRegisteredModel](), "Field1", "Values", reflect.TypeFor[*tfRegisteredModel]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
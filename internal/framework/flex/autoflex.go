@@ -102,6 +102,14 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if expander, ok := flexer.(unionExpander); ok {
+			if members, ok := registeredUnionMembers(valTo.Type()); ok && len(unionFields(valFrom.Type())) > 0 {
+				tflog.SubsystemInfo(ctx, subsystemName, "Target is a registered union")
+				diags.Append(expander.expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, members)...)
+				return diags
+			}
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -116,6 +124,15 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if flattener, ok := flexer.(unionFlattener); ok {
+		if name, ok := registeredUnionMemberName(valFrom.Type()); ok && len(unionFields(valTo.Type())) > 0 {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source is a registered union member")
+			diags.Append(flattener.flattenUnion(ctx, sourcePath, valFrom, targetPath, valTo, name)...)
+			return diags
+		}
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -147,7 +164,7 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			continue
 		}
 
-		toField, ok := findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
+		toField, converter, ok := findField(ctx, fromField, typeFrom, typeTo, flexer)
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -192,7 +209,15 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			omitempty: toOpts.OmitEmpty(),
 		}
 
-		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+		if converter != nil {
+			tflog.SubsystemTrace(ctx, subsystemName, "Using registered field converter", map[string]any{
+				logAttrKeySourceFieldname: fieldName,
+				logAttrKeyTargetFieldname: toFieldName,
+			})
+			diags.Append(converter(ctx, valFrom.Field(i), toFieldVal)...)
+		} else {
+			diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+		}
 		if diags.HasError() {
 			break
 		}
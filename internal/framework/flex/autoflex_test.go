@@ -558,6 +558,67 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type tfUnion struct {
+	String types.String                                         `tfsdk:"string" autoflex:",union"`
+	Nested fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"nested" autoflex:"Struct,union"`
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnionInterface
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnionInterface
+}
+
+type awsUnionInterface interface {
+	isAWSUnionInterface()
+}
+
+type awsUnionInterfaceMemberString struct {
+	Value string
+}
+
+func (t *awsUnionInterfaceMemberString) isAWSUnionInterface() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionInterfaceMemberStruct struct {
+	Value awsSingleStringValue
+}
+
+func (t *awsUnionInterfaceMemberStruct) isAWSUnionInterface() {} // nosemgrep:ci.aws-in-func-name
+
+type tfRegisteredModel struct {
+	Name      types.String                      `tfsdk:"name"`
+	StartTime timetypes.RFC3339                 `tfsdk:"start_time"`
+	Values    fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+}
+
+type awsRegisteredModel struct {
+	DisplayName *string
+	Name        *string
+	StartTime   int64
+	Values      *string
+}
+
+type awsRegisteredModelSingle struct {
+	Field1 *awsRegisteredModel
+}
+
+func init() {
+	RegisterModel[tfRegisteredModel](map[string]FieldMapping{
+		"name":       {Name: "DisplayName"},
+		"start_time": {Converter: EpochSecondsConverter},
+		"values":     {Converter: CommaSeparatedStringConverter},
+	})
+}
+
+func init() {
+	RegisterUnion[awsUnionInterface](
+		&awsUnionInterfaceMemberString{},
+		&awsUnionInterfaceMemberStruct{},
+	)
+}
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,6 +18,15 @@ type Results struct {
 	hasChanges            bool
 	ignoredFieldNames     []string
 	flexIgnoredFieldNames []AutoFlexOptionsFunc
+	changes               []fieldChange
+}
+
+// fieldChange represents a single field whose plan and state values differ.
+type fieldChange struct {
+	fieldName string
+	path      string
+	plan      attr.Value
+	state     attr.Value
 }
 
 // HasChanges returns whether there are changes between the plan and state values
@@ -37,6 +47,35 @@ func (r *Results) IgnoredFieldNames() []string {
 	return r.ignoredFieldNames
 }
 
+// ChangedFieldNames returns the list of changed field names
+func (r *Results) ChangedFieldNames() []string {
+	var fieldNames []string
+	for _, v := range r.changes {
+		fieldNames = append(fieldNames, v.fieldName)
+	}
+	return fieldNames
+}
+
+// HasChange returns whether the plan and state values of the specified field differ
+func (r *Results) HasChange(fieldName string) bool {
+	return slices.ContainsFunc(r.changes, func(v fieldChange) bool {
+		return v.fieldName == fieldName
+	})
+}
+
+// ExpandChanges expands only the changed fields of the plan into the AWS API update input.
+// Unchanged fields are left as their zero value, e.g.
+//
+//	input := apigateway.UpdateRestApiInput{}
+//	response.Diagnostics.Append(diff.ExpandChanges(ctx, plan, &input)...)
+//	input.RestApiId = plan.ID.ValueStringPointer()
+//
+// Identifiers required by the update input must be set explicitly if they are unchanged.
+// Unchanged field names are ignored at every level of nesting, so a nested field with the same name as an unchanged field is also not expanded.
+func (r *Results) ExpandChanges(ctx context.Context, plan, apiObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	return Expand(ctx, plan, apiObject, append(optFns, r.IgnoredFieldNamesOpts()...)...)
+}
+
 // Calculate compares the plan and state values and returns whether there are changes
 func Calculate(ctx context.Context, plan, state any, options ...ChangeOption) (*Results, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -56,8 +95,10 @@ func Calculate(ctx context.Context, plan, state any, options ...ChangeOption) (*
 	}
 
 	var hasChanges bool
+	var changes []fieldChange
 	for i := 0; i < planValue.NumField(); i++ {
-		fieldName := planType.Field(i).Name
+		field := planType.Field(i)
+		fieldName := field.Name
 
 		if shouldSkipField(fieldName, opts.IgnoredFields) {
 			ignoredFields = append(ignoredFields, fieldName)
@@ -81,6 +122,12 @@ func Calculate(ctx context.Context, plan, state any, options ...ChangeOption) (*
 
 		if !planFieldValue.Equal(stateFieldValue) {
 			hasChanges = true
+			changes = append(changes, fieldChange{
+				fieldName: fieldName,
+				path:      patchPath(field, opts.PatchPaths),
+				plan:      planFieldValue,
+				state:     stateFieldValue,
+			})
 		} else {
 			ignoredFields = append(ignoredFields, fieldName)
 		}
@@ -88,10 +135,34 @@ func Calculate(ctx context.Context, plan, state any, options ...ChangeOption) (*
 
 	result.hasChanges = hasChanges
 	result.ignoredFieldNames = ignoredFields
+	result.changes = changes
 
 	return &result, diags
 }
 
+// patchPath returns the JSON pointer of the specified field in patch operations.
+// By default the path is the field's `tfsdk` attribute name converted to lower camel case, e.g. `binary_media_types` => `/binaryMediaTypes`.
+func patchPath(field reflect.StructField, patchPaths map[string]string) string {
+	if v, ok := patchPaths[field.Name]; ok {
+		return v
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ",")
+	if name == "" || name == "-" {
+		return "/" + strings.ToLower(field.Name[:1]) + field.Name[1:]
+	}
+
+	var sb strings.Builder
+	for i, part := range strings.Split(name, "_") {
+		if i > 0 && part != "" {
+			part = strings.ToUpper(part[:1]) + part[1:]
+		}
+		sb.WriteString(part)
+	}
+
+	return "/" + sb.String()
+}
+
 func dereferencePointer(value reflect.Value) reflect.Value {
 	if value.Kind() == reflect.Ptr {
 		return value.Elem()
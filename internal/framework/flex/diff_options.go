@@ -9,6 +9,7 @@ type ChangeOption func(*ChangeOptions)
 // ChangeOptions holds configuration for calculating plan changes
 type ChangeOptions struct {
 	IgnoredFields []string
+	PatchPaths    map[string]string
 }
 
 // WithIgnoredField specifies a field name to be ignored when calculating plan changes
@@ -18,10 +19,18 @@ func WithIgnoredField(fieldName string) ChangeOption {
 	}
 }
 
+// WithPatchPath specifies the JSON pointer of a field in patch operations, overriding the default derived from its `tfsdk` attribute name
+func WithPatchPath(fieldName, path string) ChangeOption {
+	return func(o *ChangeOptions) {
+		o.PatchPaths[fieldName] = path
+	}
+}
+
 // NewChangeOptions initializes ChangeOptions with the provided options
 func NewChangeOptions(options ...ChangeOption) *ChangeOptions {
 	opts := &ChangeOptions{
 		IgnoredFields: make([]string, 0),
+		PatchPaths:    make(map[string]string),
 	}
 
 	for _, opt := range options {
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type PatchOperationType string
+
+const (
+	PatchOperationAdd     PatchOperationType = "add"
+	PatchOperationRemove  PatchOperationType = "remove"
+	PatchOperationReplace PatchOperationType = "replace"
+)
+
+// PatchOperation is a single JSON Patch (RFC 6902) style update operation, as used by APIs such as API Gateway's `PatchOperations`.
+type PatchOperation struct {
+	Op    PatchOperationType
+	Path  string
+	Value *string
+}
+
+// PatchOperations returns the patch operations that update the state values of the changed fields to their plan values.
+// Operations are generated, in field order, as follows:
+//
+//   - Primitive values are replaced with the plan value. A null plan value replaces with no value.
+//   - Elements removed from a list or set are removed, and elements added are added. The element is the last path segment, e.g. `/binaryMediaTypes/image~1png`.
+//   - Map keys removed are removed, keys added are added and keys whose value has changed are replaced. The key is the last path segment.
+//
+// Fields with an unknown plan value, e.g. computed attributes, are skipped.
+// A list whose elements are only reordered or duplicated, which cannot be patched by element, is an error.
+// Any other changed field, e.g. a nested object, is an error. Such fields should be ignored via WithIgnoredField and patched explicitly.
+func (r *Results) PatchOperations(ctx context.Context) ([]PatchOperation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var operations []PatchOperation
+
+	for _, change := range r.changes {
+		if change.plan.IsUnknown() {
+			continue
+		}
+
+		ops, d := fieldPatchOperations(ctx, change)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		operations = append(operations, ops...)
+	}
+
+	return operations, diags
+}
+
+func fieldPatchOperations(ctx context.Context, change fieldChange) ([]PatchOperation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v, ok := change.plan.(basetypes.MapValuable); ok {
+		plan, d := v.ToMapValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		state, d := change.state.(basetypes.MapValuable).ToMapValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return mapPatchOperations(ctx, change, plan.Elements(), state.Elements())
+	}
+
+	if v, ok := change.plan.(valueWithElementsAs); ok {
+		return collectionPatchOperations(ctx, change, v.Elements(), change.state.(valueWithElementsAs).Elements())
+	}
+
+	if change.plan.IsNull() {
+		return []PatchOperation{{Op: PatchOperationReplace, Path: change.path}}, diags
+	}
+
+	value, ok, d := patchValue(ctx, change.plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if !ok {
+		diags.Append(diagPatchingUnsupportedField(change))
+		return nil, diags
+	}
+
+	return []PatchOperation{{Op: PatchOperationReplace, Path: change.path, Value: &value}}, diags
+}
+
+func collectionPatchOperations(ctx context.Context, change fieldChange, plan, state []attr.Value) ([]PatchOperation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var operations []PatchOperation
+
+	for _, elem := range state {
+		if slices.ContainsFunc(plan, elem.Equal) {
+			continue
+		}
+
+		value, ok, d := patchValue(ctx, elem)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if !ok {
+			diags.Append(diagPatchingUnsupportedField(change))
+			return nil, diags
+		}
+
+		operations = append(operations, PatchOperation{Op: PatchOperationRemove, Path: change.path + "/" + escapeJSONPointer(value)})
+	}
+
+	for _, elem := range plan {
+		if slices.ContainsFunc(state, elem.Equal) {
+			continue
+		}
+
+		value, ok, d := patchValue(ctx, elem)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if !ok {
+			diags.Append(diagPatchingUnsupportedField(change))
+			return nil, diags
+		}
+
+		operations = append(operations, PatchOperation{Op: PatchOperationAdd, Path: change.path + "/" + escapeJSONPointer(value)})
+	}
+
+	// Changes to the order or number of duplicates of a list's elements cannot be patched by element.
+	if len(operations) == 0 && (len(plan) > 0 || len(state) > 0) {
+		diags.Append(diagPatchingReorderedField(change))
+		return nil, diags
+	}
+
+	return operations, diags
+}
+
+func mapPatchOperations(ctx context.Context, change fieldChange, plan, state map[string]attr.Value) ([]PatchOperation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var operations []PatchOperation
+
+	for _, key := range slices.Sorted(maps.Keys(state)) {
+		if _, ok := plan[key]; !ok {
+			operations = append(operations, PatchOperation{Op: PatchOperationRemove, Path: change.path + "/" + escapeJSONPointer(key)})
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(plan)) {
+		elem := plan[key]
+
+		op := PatchOperationAdd
+		if v, ok := state[key]; ok {
+			if v.Equal(elem) {
+				continue
+			}
+			op = PatchOperationReplace
+		}
+
+		value, ok, d := patchValue(ctx, elem)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if !ok {
+			diags.Append(diagPatchingUnsupportedField(change))
+			return nil, diags
+		}
+
+		operations = append(operations, PatchOperation{Op: op, Path: change.path + "/" + escapeJSONPointer(key), Value: &value})
+	}
+
+	return operations, diags
+}
+
+// patchValue returns the string representation of a primitive value in patch operations.
+func patchValue(ctx context.Context, value attr.Value) (string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch v := value.(type) {
+	case basetypes.StringValuable:
+		v2, d := v.ToStringValue(ctx)
+		diags.Append(d...)
+		return v2.ValueString(), true, diags
+
+	case basetypes.BoolValuable:
+		v2, d := v.ToBoolValue(ctx)
+		diags.Append(d...)
+		return strconv.FormatBool(v2.ValueBool()), true, diags
+
+	case basetypes.Int64Valuable:
+		v2, d := v.ToInt64Value(ctx)
+		diags.Append(d...)
+		return strconv.FormatInt(v2.ValueInt64(), 10), true, diags
+
+	case basetypes.Int32Valuable:
+		v2, d := v.ToInt32Value(ctx)
+		diags.Append(d...)
+		return strconv.FormatInt(int64(v2.ValueInt32()), 10), true, diags
+
+	case basetypes.Float64Valuable:
+		v2, d := v.ToFloat64Value(ctx)
+		diags.Append(d...)
+		return strconv.FormatFloat(v2.ValueFloat64(), 'f', -1, 64), true, diags
+
+	case basetypes.Float32Valuable:
+		v2, d := v.ToFloat32Value(ctx)
+		diags.Append(d...)
+		return strconv.FormatFloat(float64(v2.ValueFloat32()), 'f', -1, 32), true, diags
+	}
+
+	return "", false, diags
+}
+
+// escapeJSONPointer escapes a JSON pointer reference token, see https://datatracker.ietf.org/doc/html/rfc6901#section-3.
+func escapeJSONPointer(s string) string {
+	s = strings.ReplaceAll(s, "~", "~0")
+	s = strings.ReplaceAll(s, "/", "~1")
+	return s
+}
+
+func diagPatchingUnsupportedField(change fieldChange) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while generating patch operations. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field %q of type %q is not supported in patch operations.", change.fieldName, fullTypeName(reflect.TypeOf(change.plan))),
+	)
+}
+
+func diagPatchingReorderedField(change fieldChange) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while generating patch operations. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field %q has elements that are only reordered or duplicated, which is not supported in patch operations.", change.fieldName),
+	)
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 )
@@ -22,6 +24,52 @@ type testResourceData2 struct {
 	Name types.String
 }
 
+type testResourceData3 struct {
+	APIKeySource     types.String `tfsdk:"api_key_source"`
+	BinaryMediaTypes types.Set    `tfsdk:"binary_media_types"`
+	Description      types.String `tfsdk:"description"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	ID               types.String `tfsdk:"id"`
+	Parameters       types.Map    `tfsdk:"parameters"`
+	Timeout          types.Int64  `tfsdk:"timeout"`
+}
+
+type testResourceData4 struct {
+	Aliases types.List `tfsdk:"aliases"`
+}
+
+type testAPIUpdateInput struct {
+	APIKeySource *string
+	Description  *string
+	Enabled      *bool
+	ID           *string
+	Timeout      *int64
+}
+
+func testStringSet(elems ...string) types.Set {
+	var values []attr.Value
+	for _, v := range elems {
+		values = append(values, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func testStringList(elems ...string) types.List {
+	var values []attr.Value
+	for _, v := range elems {
+		values = append(values, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+func testStringMap(elems map[string]string) types.Map {
+	values := make(map[string]attr.Value)
+	for k, v := range elems {
+		values[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
 func TestCalculate(t *testing.T) {
 	t.Parallel()
 
@@ -141,3 +189,188 @@ func TestWithException(t *testing.T) {
 		})
 	}
 }
+
+func TestChangedFieldNames(t *testing.T) {
+	t.Parallel()
+
+	plan := testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(1), Age: types.Int64Value(100)}
+	state := testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(2), Age: types.Int64Value(200)}
+
+	results, diags := fwflex.Calculate(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	if diff := cmp.Diff(results.ChangedFieldNames(), []string{"Number", "Age"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if results.HasChange("Name") {
+		t.Error("expected no change to Name")
+	}
+
+	if !results.HasChange("Age") {
+		t.Error("expected change to Age")
+	}
+}
+
+func TestExpandChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		plan     testResourceData3
+		state    testResourceData3
+		expected testAPIUpdateInput
+	}{
+		"no change": {
+			plan:  testResourceData3{Description: types.StringValue("test"), Enabled: types.BoolValue(true), ID: types.StringValue("id1")},
+			state: testResourceData3{Description: types.StringValue("test"), Enabled: types.BoolValue(true), ID: types.StringValue("id1")},
+		},
+		"only changed fields": {
+			plan:  testResourceData3{Description: types.StringValue("test2"), Enabled: types.BoolValue(true), ID: types.StringValue("id1"), Timeout: types.Int64Value(30)},
+			state: testResourceData3{Description: types.StringValue("test"), Enabled: types.BoolValue(true), ID: types.StringValue("id1"), Timeout: types.Int64Value(10)},
+			expected: testAPIUpdateInput{
+				Description: aws.String("test2"),
+				Timeout:     aws.Int64(30),
+			},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			results, diags := fwflex.Calculate(ctx, test.plan, test.state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %v", diags)
+			}
+
+			var input testAPIUpdateInput
+			diags = results.ExpandChanges(ctx, test.plan, &input)
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %v", diags)
+			}
+
+			if diff := cmp.Diff(input, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPatchOperations(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		plan      any
+		state     any
+		options   []fwflex.ChangeOption
+		expected  []fwflex.PatchOperation
+		expectErr bool
+	}{
+		"no change": {
+			plan:  testResourceData3{Description: types.StringValue("test"), Enabled: types.BoolValue(true)},
+			state: testResourceData3{Description: types.StringValue("test"), Enabled: types.BoolValue(true)},
+		},
+		"primitives": {
+			plan:  testResourceData3{APIKeySource: types.StringValue("HEADER"), Description: types.StringNull(), Enabled: types.BoolValue(false), Timeout: types.Int64Value(30)},
+			state: testResourceData3{APIKeySource: types.StringValue("AUTHORIZER"), Description: types.StringValue("test"), Enabled: types.BoolValue(true), Timeout: types.Int64Value(10)},
+			expected: []fwflex.PatchOperation{
+				{Op: fwflex.PatchOperationReplace, Path: "/apiKeySource", Value: aws.String("HEADER")},
+				{Op: fwflex.PatchOperationReplace, Path: "/description"},
+				{Op: fwflex.PatchOperationReplace, Path: "/enabled", Value: aws.String("false")},
+				{Op: fwflex.PatchOperationReplace, Path: "/timeout", Value: aws.String("30")},
+			},
+		},
+		"set": {
+			plan:  testResourceData3{BinaryMediaTypes: testStringSet("image/png", "application/octet-stream")},
+			state: testResourceData3{BinaryMediaTypes: testStringSet("image/png", "image/jpeg")},
+			expected: []fwflex.PatchOperation{
+				{Op: fwflex.PatchOperationRemove, Path: "/binaryMediaTypes/image~1jpeg"},
+				{Op: fwflex.PatchOperationAdd, Path: "/binaryMediaTypes/application~1octet-stream"},
+			},
+		},
+		"map": {
+			plan:  testResourceData3{Parameters: testStringMap(map[string]string{"k1": "v1", "k2": "v2changed", "k4": "v4"})},
+			state: testResourceData3{Parameters: testStringMap(map[string]string{"k1": "v1", "k2": "v2", "k3": "v3"})},
+			expected: []fwflex.PatchOperation{
+				{Op: fwflex.PatchOperationRemove, Path: "/parameters/k3"},
+				{Op: fwflex.PatchOperationReplace, Path: "/parameters/k2", Value: aws.String("v2changed")},
+				{Op: fwflex.PatchOperationAdd, Path: "/parameters/k4", Value: aws.String("v4")},
+			},
+		},
+		"unknown": {
+			plan:     testResourceData3{BinaryMediaTypes: types.SetUnknown(types.StringType), ID: types.StringUnknown()},
+			state:    testResourceData3{BinaryMediaTypes: testStringSet("image/png"), ID: types.StringValue("id1")},
+			expected: nil,
+		},
+		"custom path": {
+			plan:    testResourceData3{APIKeySource: types.StringValue("HEADER")},
+			state:   testResourceData3{APIKeySource: types.StringValue("AUTHORIZER")},
+			options: []fwflex.ChangeOption{fwflex.WithPatchPath("APIKeySource", "/source/apiKey")},
+			expected: []fwflex.PatchOperation{
+				{Op: fwflex.PatchOperationReplace, Path: "/source/apiKey", Value: aws.String("HEADER")},
+			},
+		},
+		"ignored field": {
+			plan:    testResourceData3{APIKeySource: types.StringValue("HEADER"), Enabled: types.BoolValue(true)},
+			state:   testResourceData3{APIKeySource: types.StringValue("AUTHORIZER"), Enabled: types.BoolValue(false)},
+			options: []fwflex.ChangeOption{fwflex.WithIgnoredField("APIKeySource")},
+			expected: []fwflex.PatchOperation{
+				{Op: fwflex.PatchOperationReplace, Path: "/enabled", Value: aws.String("true")},
+			},
+		},
+		"list": {
+			plan:  testResourceData4{Aliases: testStringList("a", "c")},
+			state: testResourceData4{Aliases: testStringList("a", "b")},
+			expected: []fwflex.PatchOperation{
+				{Op: fwflex.PatchOperationRemove, Path: "/aliases/b"},
+				{Op: fwflex.PatchOperationAdd, Path: "/aliases/c"},
+			},
+		},
+		"list null to empty": {
+			plan:  testResourceData4{Aliases: testStringList()},
+			state: testResourceData4{Aliases: types.ListNull(types.StringType)},
+		},
+		"list reordered": {
+			plan:      testResourceData4{Aliases: testStringList("b", "a")},
+			state:     testResourceData4{Aliases: testStringList("a", "b")},
+			expectErr: true,
+		},
+		"list duplicated": {
+			plan:      testResourceData4{Aliases: testStringList("a", "a", "b")},
+			state:     testResourceData4{Aliases: testStringList("a", "b")},
+			expectErr: true,
+		},
+		"unsupported field": {
+			plan: testResourceData3{BinaryMediaTypes: types.SetValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{}}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+			})},
+			state:     testResourceData3{BinaryMediaTypes: types.SetNull(types.ObjectType{AttrTypes: map[string]attr.Type{}})},
+			expectErr: true,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			results, diags := fwflex.Calculate(ctx, test.plan, test.state, test.options...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %v", diags)
+			}
+
+			operations, diags := results.PatchOperations(ctx)
+
+			if diff := cmp.Diff(diags.HasError(), test.expectErr); diff != "" {
+				t.Fatalf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(operations, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// FieldMapping customizes how AutoFlex expands and flattens a single Terraform model field.
+type FieldMapping struct {
+	// Name is the name of the corresponding AWS API struct field.
+	// If empty, the field is matched by name as usual.
+	Name string
+
+	// Converter, if set, converts the field's value in place of AutoFlex's default conversion.
+	Converter FieldConverter
+}
+
+// FieldConverter converts a Terraform model field value to and from its AWS API representation.
+// Use NewFieldConverter to create a FieldConverter.
+type FieldConverter interface {
+	expand(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	flatten(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+}
+
+var (
+	modelsLock sync.RWMutex
+	// models maps each registered Terraform model struct type to its field mappings, keyed by Go field name.
+	models = make(map[reflect.Type]map[string]FieldMapping)
+)
+
+// RegisterModel registers field mappings for the Terraform model type T, keyed by `tfsdk` attribute name.
+// The mappings apply wherever T is expanded or flattened, including when T is nested within another model, e.g.
+//
+//	fwflex.RegisterModel[scheduleModel](map[string]fwflex.FieldMapping{
+//		"start_after": {Name: "StartAfterDateTime", Converter: fwflex.EpochSecondsConverter},
+//	})
+//
+// RegisterModel panics if T is not a struct type or has no field with a specified `tfsdk` attribute name.
+func RegisterModel[T any](fields map[string]FieldMapping) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("registering model: %s is not a struct type", fullTypeName(t)))
+	}
+
+	mappings := make(map[string]FieldMapping, len(fields))
+	for name, mapping := range fields {
+		field, ok := fieldByTfsdkName(t, name)
+		if !ok {
+			panic(fmt.Sprintf("registering model %s: no field with tfsdk name %q", fullTypeName(t), name))
+		}
+		mappings[field.Name] = mapping
+	}
+
+	modelsLock.Lock()
+	defer modelsLock.Unlock()
+
+	models[t] = mappings
+}
+
+func fieldByTfsdkName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if v, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ","); v == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// registeredModel returns the field mappings registered for the specified Terraform model type.
+func registeredModel(t reflect.Type) map[string]FieldMapping {
+	modelsLock.RLock()
+	defer modelsLock.RUnlock()
+
+	return models[t]
+}
+
+// findField returns the field in `typeTo` corresponding to the field `fromField` in `typeFrom`.
+// Field mappings registered for either type take precedence over fuzzy field name matching.
+// Also returns the conversion function of any registered FieldConverter.
+func findField(ctx context.Context, fromField reflect.StructField, typeFrom, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, func(context.Context, reflect.Value, reflect.Value) diag.Diagnostics, bool) {
+	fromModel, toModel := registeredModel(typeFrom), registeredModel(typeTo)
+
+	// Expanding from a registered model.
+	if mapping, ok := fromModel[fromField.Name]; ok {
+		var toField reflect.StructField
+		if mapping.Name != "" {
+			toField, ok = typeTo.FieldByName(mapping.Name)
+		} else {
+			toField, ok = findFieldFuzzy(ctx, fromField.Name, typeFrom, typeTo, flexer)
+		}
+		if !ok {
+			return reflect.StructField{}, nil, false
+		}
+
+		if mapping.Converter != nil {
+			return toField, mapping.Converter.expand, true
+		}
+		return toField, nil, true
+	}
+
+	// Flattening to a registered model.
+	for name, mapping := range toModel {
+		if mapping.Name != fromField.Name {
+			continue
+		}
+
+		toField, _ := typeTo.FieldByName(name)
+		if mapping.Converter != nil {
+			return toField, mapping.Converter.flatten, true
+		}
+		return toField, nil, true
+	}
+
+	toField, ok := findFieldFuzzy(ctx, fromField.Name, typeFrom, typeTo, flexer)
+	if !ok {
+		return reflect.StructField{}, nil, false
+	}
+
+	// A target field explicitly mapped to another source field doesn't fuzzy match.
+	for _, mapping := range fromModel {
+		if mapping.Name == toField.Name {
+			return reflect.StructField{}, nil, false
+		}
+	}
+	if mapping, ok := toModel[toField.Name]; ok {
+		if mapping.Name != "" {
+			return reflect.StructField{}, nil, false
+		}
+		if mapping.Converter != nil {
+			return toField, mapping.Converter.flatten, true
+		}
+	}
+
+	return toField, nil, true
+}
+
+type fieldConverter[TF attr.Value, API any] struct {
+	expandFunc  func(context.Context, TF) (API, diag.Diagnostics)
+	flattenFunc func(context.Context, API) (TF, diag.Diagnostics)
+}
+
+// NewFieldConverter returns a FieldConverter that uses `expand` to convert Terraform values of type TF to AWS API values of type API and `flatten` to convert back.
+// `expand` is not called for null or unknown values.
+// AWS API struct fields of type API, pointer to API or, if API is a pointer type, API's element type are supported.
+func NewFieldConverter[TF attr.Value, API any](expand func(context.Context, TF) (API, diag.Diagnostics), flatten func(context.Context, API) (TF, diag.Diagnostics)) FieldConverter {
+	return fieldConverter[TF, API]{
+		expandFunc:  expand,
+		flattenFunc: flatten,
+	}
+}
+
+func (c fieldConverter[TF, API]) expand(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	from, ok := vFrom.Interface().(TF)
+	if !ok {
+		diags.Append(diagExpandingIncompatibleTypes(vFrom.Type(), vTo.Type()))
+		return diags
+	}
+
+	if from.IsNull() || from.IsUnknown() {
+		return diags
+	}
+
+	to, d := c.expandFunc(ctx, from)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	v, tTo := reflect.ValueOf(&to).Elem(), vTo.Type()
+	switch {
+	case v.Type().AssignableTo(tTo):
+		vTo.Set(v)
+
+	case v.Kind() == reflect.Pointer && v.Type().Elem().AssignableTo(tTo):
+		if !v.IsNil() {
+			vTo.Set(v.Elem())
+		}
+
+	case tTo.Kind() == reflect.Pointer && v.Type().AssignableTo(tTo.Elem()):
+		p := reflect.New(tTo.Elem())
+		p.Elem().Set(v)
+		vTo.Set(p)
+
+	default:
+		diags.Append(diagExpandingIncompatibleTypes(reflect.TypeFor[API](), tTo))
+	}
+
+	return diags
+}
+
+func (c fieldConverter[TF, API]) flatten(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var from API
+	v, tFrom := reflect.ValueOf(&from).Elem(), vFrom.Type()
+	switch {
+	case tFrom.AssignableTo(v.Type()):
+		v.Set(vFrom)
+
+	case v.Kind() == reflect.Pointer && tFrom.AssignableTo(v.Type().Elem()):
+		p := reflect.New(tFrom)
+		p.Elem().Set(vFrom)
+		v.Set(p)
+
+	case tFrom.Kind() == reflect.Pointer && tFrom.Elem().AssignableTo(v.Type()):
+		if !vFrom.IsNil() {
+			v.Set(vFrom.Elem())
+		}
+
+	default:
+		diags.Append(diagFlatteningIncompatibleTypes(tFrom, vTo.Type()))
+		return diags
+	}
+
+	to, d := c.flattenFunc(ctx, from)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !reflect.TypeFor[TF]().AssignableTo(vTo.Type()) {
+		diags.Append(diagFlatteningIncompatibleTypes(tFrom, vTo.Type()))
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(to))
+
+	return diags
+}
+
+var (
+	// EpochSecondsConverter converts between an RFC3339 timestamp and a number of seconds since the Unix epoch.
+	EpochSecondsConverter = NewFieldConverter(
+		func(ctx context.Context, v timetypes.RFC3339) (*int64, diag.Diagnostics) {
+			t, diags := v.ValueRFC3339Time()
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			return aws.Int64(t.Unix()), diags
+		},
+		func(ctx context.Context, v *int64) (timetypes.RFC3339, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			if v == nil {
+				return timetypes.NewRFC3339Null(), diags
+			}
+
+			return timetypes.NewRFC3339TimeValue(time.Unix(aws.ToInt64(v), 0).UTC()), diags
+		},
+	)
+
+	// CommaSeparatedStringConverter converts between a list of strings and a comma-separated string.
+	CommaSeparatedStringConverter = NewFieldConverter(
+		func(ctx context.Context, v fwtypes.ListValueOf[types.String]) (*string, diag.Diagnostics) {
+			var elems []string
+			diags := v.ElementsAs(ctx, &elems, false)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			return aws.String(strings.Join(elems, ",")), diags
+		},
+		func(ctx context.Context, v *string) (fwtypes.ListValueOf[types.String], diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			if aws.ToString(v) == "" {
+				return fwtypes.NewListValueOfNull[types.String](ctx), diags
+			}
+
+			var elems []attr.Value
+			for _, s := range strings.Split(aws.ToString(v), ",") {
+				elems = append(elems, types.StringValue(s))
+			}
+
+			return fwtypes.NewListValueOf[types.String](ctx, elems)
+		},
+	)
+)
@@ -49,3 +49,7 @@ func (o tagOptions) OmitEmpty() bool {
 func (o tagOptions) NoFlatten() bool {
 	return o.Contains("noflatten")
 }
+
+func (o tagOptions) Union() bool {
+	return o.Contains("union")
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AWS SDK for Go v2 union (Smithy `union`) types are modelled as a sealed interface implemented by a pointer to each member type.
+// Member types are named `<Union>Member<Member>` and hold the member's value in a field named `Value`, e.g.
+//
+//	type ConfigurationMemberCognitoUserPoolConfiguration struct {
+//		Value CognitoUserPoolConfiguration
+//	}
+//
+// The Terraform model for a union has one field per member, each tagged with the `union` option.
+// The member is matched case-insensitively on the field name, or on the tag name if specified, e.g.
+//
+//	type configurationModel struct {
+//		CognitoUserPoolConfiguration fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfigurationModel] `tfsdk:"cognito_user_pool_configuration" autoflex:",union"`
+//		OpenIDConnectConfiguration   fwtypes.ListNestedObjectValueOf[openIDConnectConfigurationModel]   `tfsdk:"open_id_connect_configuration" autoflex:"OpenIdConnectConfiguration,union"`
+//	}
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+var (
+	unionsLock sync.RWMutex
+	// unionMembers maps each registered union interface type to its member (pointer) types.
+	unionMembers = make(map[reflect.Type][]reflect.Type)
+	// unionMemberNames maps each registered member struct type to its member name.
+	unionMemberNames = make(map[reflect.Type]string)
+)
+
+// RegisterUnion registers the member types of the AWS SDK for Go v2 union type T for AutoFlex.
+// Go reflection cannot enumerate the implementations of an interface, so each member must be registered before expanding, e.g.
+//
+//	fwflex.RegisterUnion[awstypes.Configuration](
+//		&awstypes.ConfigurationMemberCognitoUserPoolConfiguration{},
+//		&awstypes.ConfigurationMemberOpenIdConnectConfiguration{},
+//	)
+//
+// RegisterUnion panics if T is not an interface type or a member is not a pointer to a struct with a `Value` field.
+func RegisterUnion[T any](members ...T) {
+	tUnion := reflect.TypeFor[T]()
+	if tUnion.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("registering union: %s is not an interface type", fullTypeName(tUnion)))
+	}
+
+	unionsLock.Lock()
+	defer unionsLock.Unlock()
+
+	for _, member := range members {
+		tMember := reflect.TypeOf(member)
+		if tMember == nil || tMember.Kind() != reflect.Pointer || tMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("registering union %s: member %s is not a pointer to a struct", fullTypeName(tUnion), fullTypeName(tMember)))
+		}
+		if _, ok := tMember.Elem().FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("registering union %s: member %s has no %s field", fullTypeName(tUnion), fullTypeName(tMember), unionMemberValueFieldName))
+		}
+
+		if _, ok := unionMemberNames[tMember.Elem()]; !ok {
+			unionMembers[tUnion] = append(unionMembers[tUnion], tMember)
+		}
+		unionMemberNames[tMember.Elem()] = unionMemberName(tUnion, tMember.Elem())
+	}
+}
+
+// unionMemberName returns the member name of the specified union member type.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	name := tMember.Name()
+	if v, ok := strings.CutPrefix(name, tUnion.Name()+"Member"); ok {
+		return v
+	}
+	if _, v, ok := strings.Cut(name, "Member"); ok {
+		return v
+	}
+	return name
+}
+
+// registeredUnionMembers returns the registered member types of the specified union type.
+func registeredUnionMembers(tUnion reflect.Type) ([]reflect.Type, bool) {
+	unionsLock.RLock()
+	defer unionsLock.RUnlock()
+
+	v, ok := unionMembers[tUnion]
+	return v, ok
+}
+
+// registeredUnionMemberName returns the member name of the specified registered union member struct type.
+func registeredUnionMemberName(tMember reflect.Type) (string, bool) {
+	unionsLock.RLock()
+	defer unionsLock.RUnlock()
+
+	v, ok := unionMemberNames[tMember]
+	return v, ok
+}
+
+// unionFields returns the union member fields of the specified Terraform model struct type.
+func unionFields(t reflect.Type) []reflect.StructField {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if _, opts := autoflexTags(field); opts.Union() {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// unionFieldMemberName returns the union member name corresponding to the specified Terraform model field.
+func unionFieldMemberName(field reflect.StructField) string {
+	if name, _ := autoflexTags(field); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// isUnionMemberSet returns whether the specified Terraform model field value has been configured.
+func isUnionMemberSet(v reflect.Value) bool {
+	value, ok := v.Interface().(attr.Value)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	if value, ok := value.(valueWithElementsAs); ok {
+		return len(value.Elements()) > 0
+	}
+
+	return true
+}
+
+// unionExpander is implemented by an auto-expander (or pointer to one).
+type unionExpander interface {
+	expandUnion(context.Context, path.Path, reflect.Value, path.Path, reflect.Value, []reflect.Type) diag.Diagnostics
+}
+
+// unionFlattener is implemented by an auto-flattener (or pointer to one).
+type unionFlattener interface {
+	flattenUnion(context.Context, path.Path, reflect.Value, path.Path, reflect.Value, string) diag.Diagnostics
+}
+
+// expandUnion expands the Terraform union model `valFrom` to the AWS SDK union value `valTo`.
+// At most one member may be set. If no member is set, `valTo` is left as nil.
+func (expander autoExpander) expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, members []reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var set []reflect.StructField
+	for _, field := range unionFields(valFrom.Type()) {
+		if isUnionMemberSet(valFrom.FieldByIndex(field.Index)) {
+			set = append(set, field)
+		}
+	}
+
+	switch len(set) {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "No union member set")
+		return diags
+
+	case 1:
+
+	default:
+		names := make([]string, len(set))
+		for i, field := range set {
+			names[i] = field.Name
+			if v := field.Tag.Get("tfsdk"); v != "" {
+				names[i] = v
+			}
+		}
+		tflog.SubsystemError(ctx, subsystemName, "Multiple union members set", map[string]any{
+			logAttrKeySourceFieldname: names,
+		})
+		diags.Append(diagExpandingMultipleUnionMembers(valFrom.Type(), names))
+		return diags
+	}
+
+	field := set[0]
+	name := unionFieldMemberName(field)
+	idx := -1
+	for i, tMember := range members {
+		if strings.EqualFold(name, unionMemberName(valTo.Type(), tMember.Elem())) {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding union member", map[string]any{
+			logAttrKeySourceFieldname: field.Name,
+		})
+		diags.Append(diagExpandingNoUnionMember(valTo.Type(), name))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: field.Name,
+		logAttrKeyTargetFieldname: unionMemberValueFieldName,
+	})
+
+	member := reflect.New(members[idx].Elem())
+	_, opts := autoflexTags(field)
+	fieldOpts := fieldOpts{
+		legacy: opts.Legacy(),
+	}
+	diags.Append(expander.convert(ctx, sourcePath.AtName(field.Name), valFrom.FieldByIndex(field.Index), targetPath.AtName(unionMemberValueFieldName), member.Elem().FieldByName(unionMemberValueFieldName), fieldOpts)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(member)
+
+	return diags
+}
+
+// flattenUnion flattens the AWS SDK union member value `valFrom` to the Terraform union model `valTo`.
+// All fields other than that of the set member are null.
+func (flattener autoFlattener) flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, memberName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, field := range unionFields(valTo.Type()) {
+		if !strings.EqualFold(memberName, unionFieldMemberName(field)) {
+			continue
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: unionMemberValueFieldName,
+			logAttrKeyTargetFieldname: field.Name,
+		})
+
+		_, opts := autoflexTags(field)
+		fieldOpts := fieldOpts{
+			legacy:    opts.Legacy(),
+			omitempty: opts.OmitEmpty(),
+		}
+		diags.Append(flattener.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(field.Name), valTo.FieldByIndex(field.Index), fieldOpts)...)
+		return diags
+	}
+
+	tflog.SubsystemError(ctx, subsystemName, "No corresponding union member field")
+	diags.Append(diagFlatteningNoUnionMemberField(valFrom.Type(), valTo.Type()))
+
+	return diags
+}
+
+func diagExpandingMultipleUnionMembers(sourceType reflect.Type, fieldNames []string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Configuration",
+		fmt.Sprintf("Only one of %s may be configured, got %d.", strings.Join(fieldNames, ", "), len(fieldNames))+
+			fmt.Sprintf("\n\nSource type: %q", fullTypeName(sourceType)),
+	)
+}
+
+func diagExpandingNoUnionMember(targetType reflect.Type, memberName string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union type %q has no registered member %q.", fullTypeName(targetType), memberName),
+	)
+}
+
+func diagFlatteningNoUnionMemberField(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union member type %q has no corresponding field in %q.", fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
@@ -10,9 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
+// ResourceWithNoUpdate is implemented by resources which cannot be updated.
+type ResourceWithNoUpdate interface {
+	NoUpdate()
+}
+
 // WithNoUpdate is intended to be embedded in resources which cannot be updated.
 type WithNoUpdate struct{}
 
+func (w *WithNoUpdate) NoUpdate() {}
+
 func (w *WithNoUpdate) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	response.Diagnostics.Append(diag.NewErrorDiagnostic("not supported", "This resource's Update method should not have been called"))
 }
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RegionOverrideModel is intended to be embedded in the models of resources annotated with @RegionOverride.
+// The `region` and `assume_role_arn` arguments are added to the resource's schema by the provider.
+type RegionOverrideModel struct {
+	AssumeRoleARN types.String `tfsdk:"assume_role_arn"`
+	Region        types.String `tfsdk:"region"`
+}
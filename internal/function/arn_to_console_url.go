@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// consoleHosts maps partition IDs to AWS Management Console host names.
+var consoleHosts = map[string]string{
+	endpoints.AwsPartitionID:      "console.aws.amazon.com",
+	endpoints.AwsCnPartitionID:    "console.amazonaws.cn",
+	endpoints.AwsUsGovPartitionID: "console.amazonaws-us-gov.com",
+}
+
+var _ function.Function = arnToConsoleURLFunction{}
+
+func NewARNToConsoleURLFunction() function.Function {
+	return &arnToConsoleURLFunction{}
+}
+
+type arnToConsoleURLFunction struct{}
+
+func (f arnToConsoleURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_to_console_url"
+}
+
+func (f arnToConsoleURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_to_console_url Function",
+		MarkdownDescription: "Builds an AWS Management Console URL that opens the resource identified by an ARN. " +
+			"Regional resources open in the ARN's Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) of the resource",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f arnToConsoleURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := arnToConsoleURL(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func arnToConsoleURL(s string) (string, error) {
+	parts, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	host, ok := consoleHosts[parts.Partition]
+	if !ok {
+		return "", fmt.Errorf("unsupported partition %q", parts.Partition)
+	}
+	if parts.Region != "" {
+		host = parts.Region + "." + host
+	}
+
+	u := url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     "/go/view",
+		RawQuery: url.Values{"arn": []string{s}}.Encode(),
+	}
+
+	return u.String(), nil
+}
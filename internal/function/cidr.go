@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// cidrSubnets allocates subnets of the specified prefix lengths from a CIDR block.
+// Subnets are allocated in order, each at the lowest address following the previous subnet
+// that is aligned to the subnet's size.
+func cidrSubnets(cidrBlock string, prefixLengths []int) ([]string, error) {
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := prefix.Addr()
+	bits := addr.BitLen()
+	start := new(big.Int).SetBytes(addr.AsSlice())
+	end := new(big.Int).Add(start, new(big.Int).Lsh(big.NewInt(1), uint(bits-prefix.Bits())))
+	next := new(big.Int).Set(start)
+
+	subnets := make([]string, 0, len(prefixLengths))
+	for _, prefixLength := range prefixLengths {
+		if prefixLength < prefix.Bits() || prefixLength > bits {
+			return nil, fmt.Errorf("prefix length %d must be between %d and %d", prefixLength, prefix.Bits(), bits)
+		}
+
+		// Round up to the next multiple of the subnet size.
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+		mask := new(big.Int).Sub(size, big.NewInt(1))
+		next.Add(next, mask)
+		next.AndNot(next, mask)
+
+		if new(big.Int).Add(next, size).Cmp(end) > 0 {
+			return nil, fmt.Errorf("CIDR block %s has insufficient space for a /%d subnet", cidrBlock, prefixLength)
+		}
+
+		b := next.FillBytes(make([]byte, bits/8))
+		subnetAddr, _ := netip.AddrFromSlice(b)
+		subnets = append(subnets, netip.PrefixFrom(subnetAddr, prefixLength).String())
+
+		next.Add(next, size)
+	}
+
+	return subnets, nil
+}
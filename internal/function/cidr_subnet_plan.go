@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// VPC subnet sizing reference:
+// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+const (
+	subnetIPv4PrefixLengthMin = 16
+	subnetIPv4PrefixLengthMax = 28
+	subnetIPv6PrefixLengthMin = 44
+	subnetIPv6PrefixLengthMax = 64
+)
+
+var cidrSubnetPlanTierAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+var cidrSubnetPlanResultAttrTypes = map[string]attr.Type{
+	"availability_zone": types.StringType,
+	"cidr_block":        fwtypes.CIDRBlockType,
+	"tier":              types.StringType,
+}
+
+var _ function.Function = cidrSubnetPlanFunction{}
+
+func NewCIDRSubnetPlanFunction() function.Function {
+	return &cidrSubnetPlanFunction{}
+}
+
+type cidrSubnetPlanFunction struct{}
+
+type cidrSubnetPlanTier struct {
+	Name         string `tfsdk:"name"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+}
+
+type cidrSubnetPlanSubnet struct {
+	AvailabilityZone string            `tfsdk:"availability_zone"`
+	CIDRBlock        fwtypes.CIDRBlock `tfsdk:"cidr_block"`
+	Tier             string            `tfsdk:"tier"`
+}
+
+func (f cidrSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnet_plan"
+}
+
+func (f cidrSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnet_plan Function",
+		MarkdownDescription: "Plans VPC subnets for each tier in each Availability Zone from an IPv4 or IPv6 " +
+			"VPC CIDR block. Subnets are allocated in tier order, then Availability Zone order.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 VPC CIDR block",
+			},
+			function.ListParameter{
+				ElementType:         types.StringType,
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones in which to plan subnets",
+			},
+			function.ListParameter{
+				ElementType: types.ObjectType{
+					AttrTypes: cidrSubnetPlanTierAttrTypes,
+				},
+				Name:                "tiers",
+				MarkdownDescription: "Subnet tiers, each with a name and subnet prefix length",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: cidrSubnetPlanResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f cidrSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var availabilityZones []string
+	var tiers []cidrSubnetPlanTier
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &availabilityZones, &tiers))
+	if resp.Error != nil {
+		return
+	}
+
+	plan, err := cidrSubnetPlan(cidrBlock, availabilityZones, tiers)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: cidrSubnetPlanResultAttrTypes}, plan)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func cidrSubnetPlan(cidrBlock string, availabilityZones []string, tiers []cidrSubnetPlanTier) ([]cidrSubnetPlanSubnet, error) {
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixLengthMin, prefixLengthMax := subnetIPv4PrefixLengthMin, subnetIPv4PrefixLengthMax
+	if prefix.Addr().Is6() {
+		prefixLengthMin, prefixLengthMax = subnetIPv6PrefixLengthMin, subnetIPv6PrefixLengthMax
+	}
+
+	for i, availabilityZone := range availabilityZones {
+		if availabilityZone == "" {
+			return nil, fmt.Errorf("Availability Zone %d is empty", i)
+		}
+		if slices.Index(availabilityZones, availabilityZone) != i {
+			return nil, fmt.Errorf("duplicate Availability Zone %q", availabilityZone)
+		}
+	}
+
+	var prefixLengths []int
+	for i, tier := range tiers {
+		if tier.Name == "" {
+			return nil, fmt.Errorf("tier %d has no name", i)
+		}
+		if slices.IndexFunc(tiers, func(v cidrSubnetPlanTier) bool { return v.Name == tier.Name }) != i {
+			return nil, fmt.Errorf("duplicate tier %q", tier.Name)
+		}
+		if prefixLength := int(tier.PrefixLength); prefixLength < prefixLengthMin || prefixLength > prefixLengthMax {
+			return nil, fmt.Errorf("tier %q prefix length %d must be between %d and %d", tier.Name, prefixLength, prefixLengthMin, prefixLengthMax)
+		}
+
+		for range availabilityZones {
+			prefixLengths = append(prefixLengths, int(tier.PrefixLength))
+		}
+	}
+
+	subnets, err := cidrSubnets(cidrBlock, prefixLengths)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := make([]cidrSubnetPlanSubnet, 0, len(subnets))
+	for i, tier := range tiers {
+		for j, availabilityZone := range availabilityZones {
+			plan = append(plan, cidrSubnetPlanSubnet{
+				AvailabilityZone: availabilityZone,
+				CIDRBlock:        fwtypes.CIDRBlockValue(subnets[i*len(availabilityZones)+j]),
+				Tier:             tier.Name,
+			})
+		}
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetPlanFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetPlanFunctionConfig("10.0.0.0/16", `[
+    { name = "public", prefix_length = 24 },
+    { name = "private", prefix_length = 20 },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"availability_zone":"us-west-2a","cidr_block":"10.0.0.0/24","tier":"public"},{"availability_zone":"us-west-2b","cidr_block":"10.0.1.0/24","tier":"public"},{"availability_zone":"us-west-2c","cidr_block":"10.0.2.0/24","tier":"public"},{"availability_zone":"us-west-2a","cidr_block":"10.0.16.0/20","tier":"private"},{"availability_zone":"us-west-2b","cidr_block":"10.0.32.0/20","tier":"private"},{"availability_zone":"us-west-2c","cidr_block":"10.0.48.0/20","tier":"private"}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetPlanFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetPlanFunctionConfig("2600:1f14:abc:de00::/56", `[
+    { name = "public", prefix_length = 64 },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[{"availability_zone":"us-west-2a","cidr_block":"2600:1f14:abc:de00::/64","tier":"public"},{"availability_zone":"us-west-2b","cidr_block":"2600:1f14:abc:de01::/64","tier":"public"},{"availability_zone":"us-west-2c","cidr_block":"2600:1f14:abc:de02::/64","tier":"public"}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetPlanFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetPlanFunctionConfig("10.0.0.0/16", `[
+    { name = "public", prefix_length = 30 },
+  ]`),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*30[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*16[\s\n]*and[\s\n]*28`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetPlanFunction_duplicateTier(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetPlanFunctionConfig("10.0.0.0/16", `[
+    { name = "public", prefix_length = 24 },
+    { name = "public", prefix_length = 24 },
+  ]`),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*tier[\s\n]*"public"`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetPlanFunctionConfig(cidrBlock, tiers string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::cidr_subnet_plan(%[1]q, ["us-west-2a", "us-west-2b", "us-west-2c"], %[2]s))
+}
+`, cidrBlock, tiers)
+}
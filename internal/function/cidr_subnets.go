@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+var _ function.Function = cidrSubnetsFunction{}
+
+func NewCIDRSubnetsFunction() function.Function {
+	return &cidrSubnetsFunction{}
+}
+
+type cidrSubnetsFunction struct{}
+
+func (f cidrSubnetsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets"
+}
+
+func (f cidrSubnetsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets Function",
+		MarkdownDescription: "Allocates consecutive subnets of the specified prefix lengths from an IPv4 or IPv6 " +
+			"CIDR block. Each subnet is aligned to its size.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to allocate subnets from",
+			},
+			function.ListParameter{
+				ElementType:         types.Int64Type,
+				Name:                "prefix_lengths",
+				MarkdownDescription: "Prefix length of each subnet",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: fwtypes.CIDRBlockType,
+		},
+	}
+}
+
+func (f cidrSubnetsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var prefixLengths []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	subnets, err := cidrSubnets(cidrBlock, tfslices.ApplyToAll(prefixLengths, func(v int64) int {
+		return int(v)
+	}))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, d := types.ListValueFrom(ctx, fwtypes.CIDRBlockType, subnets)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
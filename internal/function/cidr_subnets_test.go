@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsFunctionConfig("10.0.0.0/16", "[24, 20, 24, 26]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["10.0.0.0/24","10.0.16.0/20","10.0.32.0/24","10.0.33.0/26"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsFunctionConfig("2600:1f14:abc:de00::/56", "[64, 60, 64]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2600:1f14:abc:de00::/64","2600:1f14:abc:de10::/60","2600:1f14:abc:de20::/64"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsFunctionConfig("10.0.0.1/16", "[24]"),
+				ExpectError: regexache.MustCompile(`did[\s\n]*you[\s\n]*mean[\s\n]*"10.0.0.0/16"`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsFunctionConfig("10.0.0.0/16", "[17, 17, 28]"),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*space[\s\n]*for[\s\n]*a[\s\n]*/28[\s\n]*subnet`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsFunctionConfig(cidrBlock, prefixLengths string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::cidr_subnets(%[1]q, %[2]s))
+}
+`, cidrBlock, prefixLengths)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ECR private registry and image reference:
+// https://docs.aws.amazon.com/AmazonECR/latest/userguide/Registries.html
+// https://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_ImageIdentifier.html
+var ecrImageURIRegexp = regexache.MustCompile(`^` +
+	// Registry: <registry_id>.dkr.ecr[-fips].<region>.<DNS suffix>.
+	`((\d{12})\.dkr\.ecr(?:-fips)?\.([a-z]{2}(?:-[a-z]+)+-\d)\.[a-z0-9.-]+)` +
+	// Repository name.
+	`/((?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*)` +
+	// Optional tag.
+	`(?::([a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}))?` +
+	// Optional digest.
+	`(?:@(sha256:[a-f0-9]{64}))?` +
+	`$`)
+
+var ecrImageURIParseResultAttrTypes = map[string]attr.Type{
+	"digest":          types.StringType,
+	"region":          types.StringType,
+	"registry":        types.StringType,
+	"registry_id":     types.StringType,
+	"repository_name": types.StringType,
+	"tag":             types.StringType,
+}
+
+var _ function.Function = ecrImageURIParseFunction{}
+
+func NewECRImageURIParseFunction() function.Function {
+	return &ecrImageURIParseFunction{}
+}
+
+type ecrImageURIParseFunction struct{}
+
+func (f ecrImageURIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_uri_parse"
+}
+
+func (f ecrImageURIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ecr_image_uri_parse Function",
+		MarkdownDescription: "Parses an Amazon ECR private registry image URI into its registry, repository " +
+			"name, tag and digest",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "ECR image URI to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ecrImageURIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f ecrImageURIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	match := ecrImageURIRegexp.FindStringSubmatch(arg)
+	if match == nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid ECR image URI", arg)))
+		return
+	}
+
+	optional := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+
+	value := map[string]attr.Value{
+		"digest":          optional(match[6]),
+		"region":          types.StringValue(match[3]),
+		"registry":        types.StringValue(match[1]),
+		"registry_id":     types.StringValue(match[2]),
+		"repository_name": types.StringValue(match[4]),
+		"tag":             optional(match[5]),
+	}
+
+	result, d := types.ObjectValue(ecrImageURIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRImageURIParseFunction_tag(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.us-west-2.amazonaws.com/team/example:v1.2.3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"digest":null,"region":"us-west-2","registry":"444455556666.dkr.ecr.us-west-2.amazonaws.com","registry_id":"444455556666","repository_name":"team/example","tag":"v1.2.3"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_digest(t *testing.T) {
+	t.Parallel()
+	digest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageURIParseFunctionConfig("444455556666.dkr.ecr.cn-north-1.amazonaws.com.cn/example@" + digest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"digest":"`+digest+`","region":"cn-north-1","registry":"444455556666.dkr.ecr.cn-north-1.amazonaws.com.cn","registry_id":"444455556666","repository_name":"example","tag":null}`),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageURIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageURIParseFunctionConfig("docker.io/library/nginx:latest"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*ECR[\s\n]*image[\s\n]*URI`),
+			},
+		},
+	})
+}
+
+func testECRImageURIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::ecr_image_uri_parse(%[1]q))
+}
+`, arg)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// IAM policy grammar reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_grammar.html
+
+// iamPolicyDocument is a canonical IAM policy document.
+// Fields are marshaled in declaration order, so `Version` is always first.
+type iamPolicyDocument struct {
+	Version    string              `json:"Version,omitempty"`
+	ID         string              `json:"Id,omitempty"`
+	Statements iamPolicyStatements `json:"Statement"`
+}
+
+type iamPolicyStatement struct {
+	Sid          string                                  `json:",omitempty"`
+	Effect       string                                  `json:"Effect"`
+	Principal    *iamPolicyPrincipal                     `json:",omitempty"`
+	NotPrincipal *iamPolicyPrincipal                     `json:",omitempty"`
+	Action       iamPolicyStringSet                      `json:",omitempty"`
+	NotAction    iamPolicyStringSet                      `json:",omitempty"`
+	Resource     iamPolicyStringSet                      `json:",omitempty"`
+	NotResource  iamPolicyStringSet                      `json:",omitempty"`
+	Condition    map[string]map[string]iamPolicyValueSet `json:",omitempty"`
+}
+
+// iamPolicyStatements accepts either a single statement or a list of statements.
+type iamPolicyStatements []*iamPolicyStatement
+
+func (s *iamPolicyStatements) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		var v iamPolicyStatement
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		*s = iamPolicyStatements{&v}
+		return nil
+	}
+
+	var v []*iamPolicyStatement
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
+
+func (s *iamPolicyStatement) UnmarshalJSON(b []byte) error {
+	type statement iamPolicyStatement
+	return strictUnmarshal(b, (*statement)(s))
+}
+
+// iamPolicyStringSet accepts either a single string or a list of strings.
+// Values are sorted and deduplicated.
+type iamPolicyStringSet []string
+
+func (s *iamPolicyStringSet) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	var values []string
+	switch v := v.(type) {
+	case string:
+		values = []string{v}
+	case []any:
+		for _, v := range v {
+			v, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("expected string, got %T", v)
+			}
+			values = append(values, v)
+		}
+	default:
+		return fmt.Errorf("expected string or list of strings, got %T", v)
+	}
+
+	slices.Sort(values)
+	*s = slices.Compact(values)
+	return nil
+}
+
+// iamPolicyValueSet accepts either a single condition value or a list of condition values.
+// Values are sorted and deduplicated.
+type iamPolicyValueSet []any
+
+func (s *iamPolicyValueSet) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	values, ok := v.([]any)
+	if !ok {
+		values = []any{v}
+	}
+
+	slices.SortFunc(values, func(a, b any) int {
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	})
+	*s = slices.CompactFunc(values, func(a, b any) bool {
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	})
+	return nil
+}
+
+// iamPolicyPrincipal is either the `"*"` wildcard or a map of principal type to principals.
+type iamPolicyPrincipal struct {
+	principals map[string]iamPolicyStringSet
+	wildcard   bool
+}
+
+func (p *iamPolicyPrincipal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != "*" {
+			return fmt.Errorf(`expected "*" or map of principals, got %q`, s)
+		}
+		p.wildcard = true
+		return nil
+	}
+
+	return json.Unmarshal(b, &p.principals)
+}
+
+func (p iamPolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if p.wildcard {
+		return json.Marshal("*")
+	}
+
+	return json.Marshal(p.principals)
+}
+
+func strictUnmarshal(b []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
+
+// parseIAMPolicy parses and canonicalizes an IAM policy document.
+func parseIAMPolicy(s string) (*iamPolicyDocument, error) {
+	var policy iamPolicyDocument
+	if err := strictUnmarshal([]byte(s), &policy); err != nil {
+		return nil, fmt.Errorf("invalid IAM policy document: %w", err)
+	}
+
+	for i, statement := range policy.Statements {
+		if statement == nil {
+			return nil, fmt.Errorf("invalid IAM policy document: statement %d is null", i)
+		}
+		if statement.Effect == "" {
+			return nil, fmt.Errorf("invalid IAM policy document: statement %d has no Effect", i)
+		}
+	}
+
+	return &policy, nil
+}
+
+// String returns the canonical JSON form of the policy document.
+func (p *iamPolicyDocument) String() (string, error) {
+	if p.Statements == nil {
+		p.Statements = iamPolicyStatements{}
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// mergeIAMPolicies merges the statements of the specified policies, in order.
+// Statements equivalent to an earlier statement are removed.
+// Statements with the same `Sid` must be equivalent.
+func mergeIAMPolicies(policies ...*iamPolicyDocument) (*iamPolicyDocument, error) {
+	merged := &iamPolicyDocument{
+		Statements: iamPolicyStatements{},
+	}
+
+	for _, policy := range policies {
+		// "2012-10-17" is the current version, "2008-10-17" is the only older version.
+		if policy.Version > merged.Version {
+			merged.Version = policy.Version
+		}
+		if merged.ID == "" {
+			merged.ID = policy.ID
+		}
+
+		for _, statement := range policy.Statements {
+			duplicate := false
+
+			for _, existing := range merged.Statements {
+				equivalent, err := iamPolicyStatementsEquivalent(existing, statement)
+				if err != nil {
+					return nil, err
+				}
+
+				if statement.Sid != "" && statement.Sid == existing.Sid && !equivalent {
+					return nil, fmt.Errorf("conflicting IAM policy statements with Sid %q", statement.Sid)
+				}
+
+				if equivalent && statement.Sid == existing.Sid {
+					duplicate = true
+					break
+				}
+			}
+
+			if !duplicate {
+				merged.Statements = append(merged.Statements, statement)
+			}
+		}
+	}
+
+	return merged, nil
+}
+
+func iamPolicyStatementsEquivalent(s1, s2 *iamPolicyStatement) (bool, error) {
+	p1, err := (&iamPolicyDocument{Version: "2012-10-17", Statements: iamPolicyStatements{s1}}).String()
+	if err != nil {
+		return false, err
+	}
+
+	p2, err := (&iamPolicyDocument{Version: "2012-10-17", Statements: iamPolicyStatements{s2}}).String()
+	if err != nil {
+		return false, err
+	}
+
+	return verify.PolicyStringsEquivalent(p1, p2), nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+)
+
+const (
+	defaultFilename = "imports.tf"
+)
+
+// stringsFlag is a repeatable string flag.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, " ")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+var (
+	region        = flag.String("region", "", "AWS Region, defaults to the shared configuration")
+	resourceTypes stringsFlag
+	tags          stringsFlag
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-imports-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Var(&resourceTypes, "resource-type", "Resource Groups Tagging API resource type filter, e.g. `ec2:vpc` (repeatable)")
+	flag.Var(&tags, "tag", "Tag filter, `<key>[=<value>[,<value>]]` (repeatable)")
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+	filename := defaultFilename
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: resourceTypes,
+	}
+	for _, v := range tags {
+		key, values, ok := strings.Cut(v, "=")
+		filter := awstypes.TagFilter{
+			Key: aws.String(key),
+		}
+		if ok {
+			filter.Values = strings.Split(values, ",")
+		}
+		input.TagFilters = append(input.TagFilters, filter)
+	}
+
+	var optFns []func(*config.LoadOptions) error
+	if v := *region; v != "" {
+		optFns = append(optFns, config.WithRegion(v))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		g.Fatalf("loading AWS configuration: %s", err)
+	}
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		g.Fatalf("creating provider: %s", err)
+	}
+
+	g.Infof("Discovering resources in %s", cfg.Region)
+
+	conn := resourcegroupstaggingapi.NewFromConfig(cfg)
+	imports, unsupported, err := tfresourcegroupstaggingapi.FindImports(ctx, conn, input, p.Meta().(*conns.AWSClient).ServicePackages)
+	if err != nil {
+		g.Fatalf("reading Resource Groups Tagging API Resources: %s", err)
+	}
+
+	for _, v := range unsupported {
+		g.Warnf("No provider resource type for %s", v)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes([]byte(tfresourcegroupstaggingapi.ImportBlocks(imports))); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	g.Infof("Wrote %d import blocks to %s", len(imports), filename)
+}
@@ -16,6 +16,7 @@ import (
 	"go/token"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -125,6 +126,12 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	Identity                bool
+	IdentityARNAttribute    string
+	IdentityAttributes      []string
+	IdentityGlobal          bool
+	IdentitySeparator       string
+	RegionOverride          bool
 }
 
 type ServiceDatum struct {
@@ -228,6 +235,50 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		// Look for resource identity annotations.
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && (m[1] == "ArnIdentity" || m[1] == "Identity") {
+			args := common.ParseArgs(m[3])
+
+			if d.Identity {
+				v.errs = append(v.errs, fmt.Errorf("multiple identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.Identity = true
+
+			if m[1] == "ArnIdentity" {
+				attr := "arn"
+				if len(args.Positional) > 0 {
+					attr = args.Positional[0]
+				}
+				d.IdentityARNAttribute = namesgen.ConstOrQuote(attr)
+			} else {
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no identity attributes: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
+				for _, attr := range args.Positional {
+					d.IdentityAttributes = append(d.IdentityAttributes, namesgen.ConstOrQuote(attr))
+				}
+
+				if attr, ok := args.Keyword["separator"]; ok {
+					d.IdentitySeparator = attr
+				}
+			}
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IdentityGlobal = b
+				}
+			}
+		}
+
+		// Look for per-resource region and IAM role override annotations.
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "RegionOverride" {
+			d.RegionOverride = true
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -287,7 +338,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ArnIdentity", "Identity", "RegionOverride", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpricing "github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+)
+
+// costEstimateWarning returns the estimated monthly cost of a resource as a warning if it is at or above the provider configured threshold.
+// Estimates are reported when the resource is planned for creation and on any planned update that changes the estimate.
+func costEstimateWarning(ctx context.Context, typeName string, meta *conns.AWSClient, prior, planned cty.Value) *tfprotov5.Diagnostic {
+	costEstimationConfig := meta.CostEstimationConfig(ctx)
+	if costEstimationConfig == nil || !tfpricing.CostEstimateSupported(typeName) {
+		return nil
+	}
+
+	if !prior.IsNull() && !tfpricing.CostEstimateChanged(typeName, attributeChanged(prior, planned)) {
+		return nil
+	}
+
+	region := plannedRegion(planned, meta)
+	estimate, err := tfpricing.EstimateMonthlyCost(ctx, meta.PricingClient(ctx), typeName, region, attributeGetter(planned))
+
+	// Cost estimation must never fail the plan.
+	if err != nil {
+		tflog.Warn(ctx, "estimating monthly cost", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	if estimate == nil || estimate.MonthlyCost < costEstimationConfig.MonthlyThreshold {
+		return nil
+	}
+
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "Estimated monthly cost",
+		Detail: fmt.Sprintf("%s estimated monthly cost is $%.2f (%s), at or above the provider cost_estimation monthly_threshold of $%.2f. "+
+			"The estimate is based on on-demand pricing in %s and excludes usage-based charges.",
+			typeName, estimate.MonthlyCost, estimate, costEstimationConfig.MonthlyThreshold, region),
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// providerConfigUnknown returns whether the value of any provider configuration attribute that determines the AWS account, Region or endpoints used is unknown,
+// e.g. because it depends on a resource that has not yet been created.
+func providerConfigUnknown(config cty.Value) bool {
+	if config.IsNull() {
+		return false
+	}
+	if !config.IsKnown() {
+		return true
+	}
+
+	for _, name := range conns.DeferrableConfigAttributes {
+		if !config.GetAttr(name).IsWhollyKnown() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestProviderConfigUnknown(t *testing.T) {
+	t.Parallel()
+
+	assumeRoleType := cty.List(cty.Object(map[string]cty.Type{
+		"role_arn": cty.String,
+	}))
+
+	config := func(values map[string]cty.Value) cty.Value {
+		attrs := map[string]cty.Value{
+			"max_retries": cty.NullVal(cty.Number),
+		}
+		for _, name := range conns.DeferrableConfigAttributes {
+			if name == "assume_role" {
+				attrs[name] = cty.NullVal(assumeRoleType)
+			} else {
+				attrs[name] = cty.NullVal(cty.String)
+			}
+		}
+		for name, v := range values {
+			attrs[name] = v
+		}
+		return cty.ObjectVal(attrs)
+	}
+
+	testCases := map[string]struct {
+		config   cty.Value
+		expected bool
+	}{
+		"null": {
+			config: cty.NullVal(cty.DynamicPseudoType),
+		},
+		"unknown": {
+			config:   cty.UnknownVal(cty.DynamicPseudoType),
+			expected: true,
+		},
+		"known": {
+			config: config(map[string]cty.Value{
+				"region": cty.StringVal("us-west-2"),
+			}),
+		},
+		"region unknown": {
+			config: config(map[string]cty.Value{
+				"region": cty.UnknownVal(cty.String),
+			}),
+			expected: true,
+		},
+		"assume_role role_arn unknown": {
+			config: config(map[string]cty.Value{
+				"assume_role": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"role_arn": cty.UnknownVal(cty.String),
+					}),
+				}),
+			}),
+			expected: true,
+		},
+		"other attribute unknown": {
+			config: config(map[string]cty.Value{
+				"max_retries": cty.UnknownVal(cty.Number),
+				"region":      cty.StringVal("us-west-2"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := providerConfigUnknown(testCase.config), testCase.expected; got != want {
+				t.Errorf("providerConfigUnknown() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// providerConfigUnknown returns whether the value of any provider configuration attribute that determines the AWS account, Region or endpoints used is unknown,
+// e.g. because it depends on a resource that has not yet been created.
+func providerConfigUnknown(config tfsdk.Config) bool {
+	if config.Raw.IsNull() {
+		return false
+	}
+	if !config.Raw.IsKnown() {
+		return true
+	}
+
+	for _, name := range conns.DeferrableConfigAttributes {
+		v, _, err := tftypes.WalkAttributePath(config.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			continue
+		}
+
+		if v, ok := v.(tftypes.Value); ok && !v.IsFullyKnown() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// overrideDeferModifyPlan defers the planned create or update of a resource whose per-resource `region` or `assume_role_arn` is unknown,
+// as the AWS account and Region that identify the resource are unknown.
+// Returns whether the plan was deferred.
+func overrideDeferModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) bool {
+	if !request.ClientCapabilities.DeferralAllowed {
+		return false
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return false
+	}
+
+	unknown, diags := overrideConfigUnknown(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	if !unknown || response.Diagnostics.HasError() {
+		return false
+	}
+
+	response.Deferred = &resource.Deferred{
+		Reason: resource.DeferredReasonResourceConfigUnknown,
+	}
+
+	return true
+}
+
+func overrideConfigUnknown(ctx context.Context, config tfsdk.Config) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var assumeRoleARN, region types.String
+
+	diags.Append(config.GetAttribute(ctx, path.Root(attrAssumeRoleARN), &assumeRoleARN)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+	return assumeRoleARN.IsUnknown() || region.IsUnknown(), diags
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// importIdentity parses any structured resource identity from an import ID and returns the import ID to pass to the resource's importer.
+// For resources that support per-resource overrides the identity's Region is set in state and the override placed in Context.
+func importIdentity(ctx context.Context, identity *itypes.ServicePackageResourceIdentity, override bool, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient) (context.Context, string) {
+	v, id, err := identity.ParseImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("parsing import ID", err.Error())
+		return ctx, ""
+	}
+
+	if v == nil {
+		return ctx, id
+	}
+
+	if override && v.Region != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), v.Region)...)
+		if response.Diagnostics.HasError() {
+			return ctx, ""
+		}
+		ctx = conns.NewOverrideContext(ctx, conns.Override{Region: v.Region})
+	}
+
+	response.Diagnostics.Append(validateIdentity(ctx, v, meta)...)
+
+	return ctx, id
+}
+
+// validateIdentity validates a resource identity against the effective provider configuration, taking into account any per-resource override carried in Context.
+func validateIdentity(ctx context.Context, identity *itypes.ResourceIdentity, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil {
+		return diags
+	}
+
+	o, _ := conns.OverrideFromContext(ctx)
+	c, err := meta.WithOverride(ctx, o)
+	if err != nil {
+		diags.AddError("resolving per-resource override", err.Error())
+		return diags
+	}
+
+	if err := identity.Validate(c.AccountID, c.Region); err != nil {
+		diags.AddError("validating resource identity", err.Error())
+	}
+
+	return diags
+}
+
+// identityResourceInterceptor validates the identity of ARN-identified resources after Read.
+type identityResourceInterceptor struct {
+	identity *itypes.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		if r.identity.ARNAttribute == "" {
+			return ctx, diags
+		}
+
+		var arn types.String
+		diags.Append(response.State.GetAttribute(ctx, path.Root(r.identity.ARNAttribute), &arn)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if arn.IsNull() || arn.IsUnknown() || arn.ValueString() == "" {
+			return ctx, diags
+		}
+
+		v, err := r.identity.FromARN(arn.ValueString())
+		if err != nil {
+			diags.AddError("validating resource identity", err.Error())
+			return ctx, diags
+		}
+
+		diags.Append(validateIdentity(ctx, v, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
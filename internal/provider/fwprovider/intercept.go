@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -438,13 +439,24 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// identity is set if the resource has declared its structured resource identity.
+	identity *types.ServicePackageResourceIdentity
+	// override is set if the resource supports per-resource region and IAM role overrides.
+	override bool
+	// tagPolicy is set if the resource supports transparent tagging and so is subject to any provider configured tag policy.
+	tagPolicy bool
+	typeName  string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, typeName string, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, v *types.ServicePackageFrameworkResource) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
+		identity:         v.Identity,
 		inner:            inner,
 		interceptors:     interceptors,
+		override:         v.RegionOverride,
+		tagPolicy:        v.Tags != nil,
+		typeName:         typeName,
 	}
 }
 
@@ -456,6 +468,11 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.override {
+		_, noUpdate := w.inner.(framework.ResourceWithNoUpdate)
+		addOverrideSchema(&response.Schema, !noUpdate)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -509,6 +526,26 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.identity != nil {
+			ctx, request.ID = importIdentity(ctx, w.identity, w.override, request, response, w.meta)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if w.override {
+			var region string
+			request.ID, region = importOverride(request)
+
+			if region != "" {
+				response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+				ctx = conns.NewOverrideContext(ctx, conns.Override{Region: region})
+			}
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
@@ -521,6 +558,24 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.override {
+		if overrideDeferModifyPlan(ctx, request, response) || response.Diagnostics.HasError() {
+			return
+		}
+
+		overrideModifyPlan(w.bootstrapContext(ctx, w.meta), request, response, w.meta)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if w.tagPolicy {
+		tagPolicyModifyPlan(w.bootstrapContext(ctx, w.meta), w.typeName, request, response, w.meta)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
@@ -700,6 +755,9 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 
 		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
+		// Report any tags changed outside Terraform on refresh.
+		diags = tagDriftRead(ctx, request, response, apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig), serviceName, resourceName, meta, diags)
+
 		// AWS APIs often return empty lists of tags when none have been configured.
 		var stateTags tftags.Map
 		response.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)
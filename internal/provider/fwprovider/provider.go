@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -169,6 +170,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"api_rate_limit": schema.ListNestedBlock{
+				Description: "Configuration block with settings to limit the rate and concurrency of AWS API calls per service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrency": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent API calls.",
+						},
+						"operations": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "API operation names, e.g. `ChangeResourceRecordSets`, to limit. If not set, all of the service's operations are limited.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum number of API requests per second, including retries.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` block, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -252,6 +277,20 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"cost_estimation": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to report the estimated monthly cost of resources as warnings.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"monthly_threshold": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The estimated monthly cost, in USD, at or above which a warning is reported.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -268,6 +307,24 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"emulator": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to run against a local AWS emulator.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The AWS account ID reported by the emulator. Defaults to `" + conns.DefaultEmulatorAccountID + "`.",
+						},
+						"endpoint": schema.StringAttribute{
+							Required:    true,
+							Description: "The base URL of the emulator, e.g. `http://localhost:4566`. Used for all service endpoints not configured in the `endpoints` block.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -291,6 +348,95 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"retry": schema.ListNestedBlock{
+				Description: "Configuration block with settings to retry AWS API calls per service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional API error codes, e.g. `MalformedPolicyDocument`, to retry.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts of each API call, including the initial call. Overrides `max_retries`.",
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum backoff delay between attempts, e.g. `30s`. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` block, e.g. `iam`.",
+						},
+					},
+				},
+			},
+			"tag_drift": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to report resource tags changed outside Terraform as warnings.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"suggest_ignore_key_prefixes": schema.BoolAttribute{
+							Optional: true,
+							Description: "Whether the prefixes of tag keys added outside Terraform, up to and including the first `" + tftags.DriftKeyPrefixDelimiter + "`, " +
+								"are suggested for the provider's `ignore_tags` `key_prefixes`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to enforce a tag policy across all resources that support tags.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether tag policy violations are reported as errors or warnings, or not checked. Valid values are `disabled`, `error` and `warning`.",
+						},
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Letter case required of tag keys. Valid values are `camel`, `kebab`, `lower`, `pascal`, `snake` and `upper`.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tag keys that every resource that supports tags must have.",
+						},
+						"resource_enforcement": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Map of resource type name to tag policy enforcement, overriding `enforcement`. Valid values are `disabled`, `error` and `warning`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Rules for the values of a tag.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values that the tag may have.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Tag key.",
+									},
+									"value_pattern": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that the tag's value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -299,6 +445,15 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 // Terraform sends to the provider the values the user specified in the
 // provider configuration block.
 func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
+	// If the provider configuration is unknown, defer all resources and data sources instead of configuring with incomplete values.
+	if request.ClientCapabilities.DeferralAllowed && providerConfigUnknown(request.Config) {
+		tflog.Info(ctx, "Provider configuration unknown, deferring")
+		response.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+		return
+	}
+
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
@@ -336,7 +491,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -407,7 +562,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -418,6 +573,24 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			if v.RegionOverride {
+				// The resource has opted in to per-resource region and IAM role overrides.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+				if _, ok := schemaResponse.Schema.Attributes[attrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", attrAssumeRoleARN, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, regionOverrideResourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -446,8 +619,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity != nil {
+				// The resource has declared its structured resource identity.
+				// Ensure that the identifying attributes are in the schema.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				identityAttributes := v.Identity.IdentifyingAttributes()
+				if i := slices.IndexFunc(identityAttributes, func(attr string) bool {
+					_, ok := schemaResponse.Schema.Attributes[attr]
+					return !ok
+				}); i >= 0 {
+					errs = append(errs, fmt.Errorf("no `%s` identity attribute defined in schema: %s", identityAttributes[i], typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, identityResourceInterceptor{identity: v.Identity})
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, typeName, inner, interceptors, v)
 			})
 		}
 	}
@@ -486,9 +677,13 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					continue
 				}
 
+				metadataResponse := ephemeral.MetadataResponse{}
+				inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+				typeName := metadataResponse.TypeName
+
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, typeName)
 					if meta != nil {
 						ctx = meta.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
@@ -521,6 +716,13 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewARNToConsoleURLFunction,
+		tffunction.NewCIDRSubnetPlanFunction,
+		tffunction.NewCIDRSubnetsFunction,
+		tffunction.NewECRImageURIParseFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3URIParseFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
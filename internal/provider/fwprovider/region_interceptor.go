@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	attrAssumeRoleARN = "assume_role_arn"
+)
+
+// addOverrideSchema adds the per-resource `region` and `assume_role_arn` arguments to a resource schema.
+// Changing `assume_role_arn` replaces resources which cannot be updated.
+func addOverrideSchema(s *schema.Schema, hasUpdate bool) {
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]schema.Attribute)
+	}
+
+	var assumeRoleARNPlanModifiers []planmodifier.String
+	if !hasUpdate {
+		assumeRoleARNPlanModifiers = append(assumeRoleARNPlanModifiers, stringplanmodifier.RequiresReplace())
+	}
+
+	s.Attributes[attrAssumeRoleARN] = schema.StringAttribute{
+		Optional:      true,
+		PlanModifiers: assumeRoleARNPlanModifiers,
+		Validators: []validator.String{
+			fwvalidators.ARN(),
+		},
+		Description: "ARN of an IAM role to assume when managing this resource, overriding the provider configuration.",
+	}
+	s.Attributes[names.AttrRegion] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+				// Don't replace resources whose state predates per-resource overrides.
+				response.RequiresReplace = !request.ConfigValue.IsNull() && !request.StateValue.IsNull()
+			}, "Replace the resource if the configured region changes.", "Replace the resource if the configured region changes."),
+		},
+		Description: "The region in which to manage this resource, overriding the provider configuration.",
+	}
+}
+
+type overrideAttributeGetter interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}
+
+// expandOverride returns any per-resource override configured in the specified plan or state.
+func expandOverride(ctx context.Context, data overrideAttributeGetter) (conns.Override, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var assumeRoleARN, region types.String
+
+	diags.Append(data.GetAttribute(ctx, path.Root(attrAssumeRoleARN), &assumeRoleARN)...)
+	diags.Append(data.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+	return conns.Override{
+		AssumeRoleARN: assumeRoleARN.ValueString(),
+		Region:        region.ValueString(),
+	}, diags
+}
+
+// overrideRegion returns the effective region for any per-resource override carried in Context.
+func overrideRegion(ctx context.Context, meta *conns.AWSClient) (string, error) {
+	o, _ := conns.OverrideFromContext(ctx)
+	v, err := meta.WithOverride(ctx, o)
+	if err != nil {
+		return "", err
+	}
+
+	return v.Region, nil
+}
+
+// regionOverrideResourceInterceptor implements per-resource region and IAM role overrides.
+// The override is placed in Context, and the effective region is set in state after CRU.
+type regionOverrideResourceInterceptor struct{}
+
+func (r regionOverrideResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		o, d := expandOverride(ctx, request.Plan)
+		diags.Append(d...)
+		ctx = conns.NewOverrideContext(ctx, o)
+	case After:
+		diags.Append(setOverrideRegion(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionOverrideResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		o, d := expandOverride(ctx, request.State)
+		diags.Append(d...)
+		ctx = conns.NewOverrideContext(ctx, o)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionOverrideResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		o, d := expandOverride(ctx, request.Plan)
+		diags.Append(d...)
+		ctx = conns.NewOverrideContext(ctx, o)
+	case After:
+		diags.Append(setOverrideRegion(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionOverrideResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		o, d := expandOverride(ctx, request.State)
+		diags.Append(d...)
+		ctx = conns.NewOverrideContext(ctx, o)
+	}
+
+	return ctx, diags
+}
+
+func setOverrideRegion(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	region, err := overrideRegion(ctx, meta)
+	if err != nil {
+		diags.AddError("resolving per-resource override", err.Error())
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+
+	return diags
+}
+
+// overrideModifyPlan plans the effective region when `region` is not configured.
+// If the provider's region has changed, the resource is replaced, as it would be without per-resource overrides.
+func overrideModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion, stateRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !configRegion.IsNull() {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Don't replace resources whose state predates per-resource overrides.
+		if !stateRegion.IsNull() && stateRegion.ValueString() != meta.Region {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region)...)
+}
+
+// importOverride parses any `@<region>` suffix from an import ID.
+func importOverride(request resource.ImportStateRequest) (string, string) {
+	i := strings.LastIndex(request.ID, "@")
+	if i < 0 {
+		return request.ID, ""
+	}
+
+	id, region := request.ID[:i], request.ID[i+1:]
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		return request.ID, ""
+	}
+
+	return id, region
+}
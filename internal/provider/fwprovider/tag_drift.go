@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagDriftRead reports any resource tags changed outside Terraform since the resource was last read as a warning.
+// If so configured, the prefixes of tag keys added outside Terraform are suggested for the provider's ignore_tags key_prefixes.
+// The tags read must already exclude any ignored tags.
+func tagDriftRead(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, tags tftags.KeyValueTags, serviceName, resourceName string, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	driftConfig := meta.TagDriftConfig(ctx)
+	if driftConfig == nil {
+		return diags
+	}
+
+	// Will occur on import.
+	if request.State.Raw.IsNull() {
+		return diags
+	}
+
+	var stateTagsAll tftags.Map
+	if d := request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll); d.HasError() {
+		return diags
+	}
+	if stateTagsAll.IsNull() || stateTagsAll.IsUnknown() {
+		return diags
+	}
+
+	drift := tags.Drift(tftags.New(ctx, stateTagsAll).IgnoreConfig(meta.IgnoreTagsConfig(ctx)))
+	if len(drift) == 0 {
+		return diags
+	}
+
+	// The configuration is not available during refresh, so the resource's tags in state stand in for its configured tags.
+	var configured tftags.KeyValueTags
+	var stateTags tftags.Map
+	if d := request.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags); !d.HasError() && !stateTags.IsUnknown() {
+		configured = tftags.New(ctx, stateTags)
+	}
+	if defaultTagsConfig := meta.DefaultTagsConfig(ctx); defaultTagsConfig != nil {
+		configured = configured.Merge(defaultTagsConfig.Tags)
+	}
+	prefixes := driftConfig.KeyPrefixSuggestions(drift, configured)
+
+	var id types.String
+	response.State.GetAttribute(ctx, path.Root(names.AttrID), &id)
+
+	diags.AddWarning("Resource tags changed outside Terraform", tagDriftDetail(fmt.Sprintf("%s %s (%s)", serviceName, resourceName, id.ValueString()), drift, prefixes))
+
+	return diags
+}
+
+func tagDriftDetail(resource string, drift []tftags.Drift, prefixes []string) string {
+	var detail strings.Builder
+
+	fmt.Fprintf(&detail, "%s tags changed outside Terraform:", resource)
+	for _, v := range drift {
+		fmt.Fprintf(&detail, "\n  - %s", v)
+	}
+
+	if len(prefixes) > 0 {
+		fmt.Fprintf(&detail, "\n\nTo stop reporting tags with these key prefixes, consider adding them to the provider's ignore_tags key_prefixes: %s.", strings.Join(prefixes, ", "))
+	}
+
+	return detail.String()
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyModifyPlan checks the resource's configured tags, merged with any provider configured default_tags,
+// against the provider configured tag policy.
+// Tags with unknown values are checked for presence only. If the resource's tags are wholly unknown no check is made.
+func tagPolicyModifyPlan(ctx context.Context, typeName string, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || meta == nil {
+		return
+	}
+
+	enforcement := meta.TagPolicyConfig(ctx).EnforcementFor(typeName)
+	if enforcement == tftags.PolicyEnforcementDisabled {
+		return
+	}
+
+	var configTags tftags.Map
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTags), &configTags)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if configTags.IsUnknown() {
+		return
+	}
+
+	tags := make(map[string]*string)
+	for k, v := range configTags.Elements() {
+		if v.IsNull() {
+			continue
+		}
+		if v.IsUnknown() {
+			tags[k] = nil
+			continue
+		}
+		if v, ok := v.(types.String); ok {
+			tags[k] = v.ValueStringPointer()
+		}
+	}
+
+	violations := meta.TagPolicyConfig(ctx).Violations(meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, tags)))
+	if len(violations) == 0 {
+		return
+	}
+
+	summary := "Tag policy violation"
+	detail := fmt.Sprintf("%s tags violate the provider tag_policy:\n  - %s", typeName, strings.Join(violations, "\n  - "))
+
+	switch enforcement {
+	case tftags.PolicyEnforcementError:
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+	case tftags.PolicyEnforcementWarning:
+		response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+	}
+}
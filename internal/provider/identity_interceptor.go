@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// importIdentity parses any structured resource identity from the import ID, setting the resource's ID to the import ID expected by the resource's importer.
+// For resources that support per-resource overrides the identity's Region is set in state.
+func importIdentity(d *schema.ResourceData, identity *types.ServicePackageResourceIdentity, override bool) (*types.ResourceIdentity, error) {
+	v, id, err := identity.ParseImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+
+	if v != nil && override && v.Region != "" {
+		if err := d.Set(names.AttrRegion, v.Region); err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+// validateIdentity validates a resource identity against the effective provider configuration.
+func validateIdentity(identity *types.ResourceIdentity, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	return identity.Validate(c.AccountID, c.Region)
+}
+
+// identityInterceptor validates the identity of ARN-identified resources after Read.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if r.identity.ARNAttribute == "" {
+				return ctx, diags
+			}
+
+			arn, _ := d.Get(r.identity.ARNAttribute).(string)
+			if arn == "" {
+				return ctx, diags
+			}
+
+			v, err := r.identity.FromARN(arn)
+			if err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if err := validateIdentity(v, meta); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
@@ -108,6 +108,12 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 			}
 		}
 
+		// Apply any per-resource override to the provider Meta.
+		meta, err := overrideMeta(ctx, meta)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
 		diags = f(ctx, d, meta)
@@ -153,6 +159,15 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// identity is set if the resource has declared its structured resource identity.
+	identity *types.ServicePackageResourceIdentity
+	// override is set if the resource supports per-resource region and IAM role overrides.
+	override bool
+	// overrideComputedKeys are the computed attributes whose values depend on the AWS account or Region in which the resource is managed.
+	overrideComputedKeys []string
+	// tagPolicy is set if the resource supports transparent tagging and so is subject to any provider configured tag policy.
+	tagPolicy bool
+	typeName  string
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -175,6 +190,34 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		var identity *types.ResourceIdentity
+		if r.identity != nil {
+			var err error
+			identity, err = importIdentity(d, r.identity, r.override)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if r.override {
+			if err := importOverride(d); err != nil {
+				return nil, err
+			}
+
+			var err error
+			ctx = conns.NewOverrideContext(ctx, expandOverride(d))
+			meta, err = overrideMeta(ctx, meta)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if identity != nil {
+			if err := validateIdentity(identity, meta); err != nil {
+				return nil, err
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -182,8 +225,42 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
+		customizeDiff := f
+
+		if r.override {
+			if overrideConfigUnknown(d) {
+				// The AWS account and Region in which the resource is managed are not yet known.
+				// Plan the attributes that depend on them as unknown and skip the resource's own CustomizeDiff.
+				if err := overrideUnknownCustomizeDiff(ctx, d, r.overrideComputedKeys); err != nil {
+					return err
+				}
 
-		return f(ctx, d, meta)
+				customizeDiff = nil
+			} else {
+				if err := overrideCustomizeDiff(ctx, d, meta); err != nil {
+					return err
+				}
+
+				var err error
+				ctx = conns.NewOverrideContext(ctx, expandOverride(d))
+				meta, err = overrideMeta(ctx, meta)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if r.tagPolicy {
+			if err := tagPolicyCustomizeDiff(ctx, r.typeName, d, meta); err != nil {
+				return err
+			}
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		return customizeDiff(ctx, d, meta)
 	}
 }
 
@@ -359,6 +436,11 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
 
+			// Report any tags changed outside Terraform on refresh.
+			if why == Read {
+				diags = tagDriftRead(ctx, d, tags, serviceName, resourceName, meta, diags)
+			}
+
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d, names.AttrTags, nil).Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
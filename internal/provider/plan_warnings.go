@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// planWarningsProviderServer wraps the Terraform Plugin SDK provider server to report warnings when resource changes are planned.
+// Terraform Plugin SDK CustomizeDiff functions cannot return warnings.
+type planWarningsProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+
+	taggedResourceTypesOnce sync.Once
+	taggedResourceTypes     map[string]struct{}
+}
+
+func newPlanWarningsProviderServer(provider *schema.Provider) tfprotov5.ProviderServer {
+	return &planWarningsProviderServer{
+		ProviderServer: provider.GRPCProvider(),
+		provider:       provider,
+	}
+}
+
+func (s *planWarningsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || response.Deferred != nil || response.PlannedState == nil {
+		return response, err
+	}
+
+	if slices.ContainsFunc(response.Diagnostics, func(d *tfprotov5.Diagnostic) bool {
+		return d.Severity == tfprotov5.DiagnosticSeverityError
+	}) {
+		return response, nil
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	meta, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok {
+		return response, nil
+	}
+
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	planned, err := decodeDynamicValue(response.PlannedState, ty)
+	if err != nil {
+		tflog.Warn(ctx, "decoding planned state", map[string]any{
+			"error": err.Error(),
+		})
+		return response, nil
+	}
+
+	// Nothing is reported for resources planned for destruction.
+	if planned.IsNull() {
+		return response, nil
+	}
+
+	if s.taggedResourceType(ctx, meta, request.TypeName) {
+		if config, err := decodeDynamicValue(request.Config, ty); err != nil {
+			tflog.Warn(ctx, "decoding configuration", map[string]any{
+				"error": err.Error(),
+			})
+		} else if v := tagPolicyWarning(ctx, request.TypeName, meta, config); v != nil {
+			response.Diagnostics = append(response.Diagnostics, v)
+		}
+	}
+
+	prior, err := decodeDynamicValue(request.PriorState, ty)
+	if err != nil {
+		tflog.Warn(ctx, "decoding prior state", map[string]any{
+			"error": err.Error(),
+		})
+		return response, nil
+	}
+
+	// Cost estimates are not reported for resources with no planned changes.
+	if prior.RawEquals(planned) {
+		return response, nil
+	}
+
+	if v := costEstimateWarning(ctx, request.TypeName, meta, prior, planned); v != nil {
+		response.Diagnostics = append(response.Diagnostics, v)
+	}
+
+	return response, nil
+}
+
+// taggedResourceType returns whether the specified resource type supports transparent tagging and so is subject to any provider configured tag policy.
+func (s *planWarningsProviderServer) taggedResourceType(ctx context.Context, meta *conns.AWSClient, typeName string) bool {
+	s.taggedResourceTypesOnce.Do(func() {
+		s.taggedResourceTypes = make(map[string]struct{})
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				if v.Tags != nil {
+					s.taggedResourceTypes[v.TypeName] = struct{}{}
+				}
+			}
+		}
+	})
+
+	_, ok := s.taggedResourceTypes[typeName]
+	return ok
+}
+
+func decodeDynamicValue(v *tfprotov5.DynamicValue, ty cty.Type) (cty.Value, error) {
+	switch {
+	case v == nil:
+		return cty.NullVal(ty), nil
+	case len(v.MsgPack) > 0:
+		return msgpack.Unmarshal(v.MsgPack, ty)
+	case len(v.JSON) > 0:
+		return ctyjson.Unmarshal(v.JSON, ty)
+	default:
+		return cty.NullVal(ty), nil
+	}
+}
+
+// attributeGetter returns a function that returns the value of a top-level attribute of the specified object
+// as a string, int or bool. nil is returned for unknown, null and other values.
+func attributeGetter(obj cty.Value) func(string) any {
+	return func(key string) any {
+		if !obj.Type().IsObjectType() || !obj.Type().HasAttribute(key) {
+			return nil
+		}
+
+		v := obj.GetAttr(key)
+		if v.IsNull() || !v.IsKnown() {
+			return nil
+		}
+
+		switch v.Type() {
+		case cty.Bool:
+			return v.True()
+		case cty.Number:
+			i, _ := v.AsBigFloat().Int64()
+			return int(i)
+		case cty.String:
+			return v.AsString()
+		default:
+			return nil
+		}
+	}
+}
+
+// attributeChanged returns a function that returns whether the value of a top-level attribute differs between two objects.
+func attributeChanged(o, n cty.Value) func(string) bool {
+	return func(key string) bool {
+		if !o.Type().IsObjectType() || !o.Type().HasAttribute(key) {
+			return false
+		}
+
+		return !o.GetAttr(key).RawEquals(n.GetAttr(key))
+	}
+}
+
+// plannedRegion returns the Region in which a resource is planned to be managed.
+func plannedRegion(planned cty.Value, meta *conns.AWSClient) string {
+	if v, ok := attributeGetter(planned)(names.AttrRegion).(string); ok && v != "" {
+		return v
+	}
+
+	return meta.Region
+}
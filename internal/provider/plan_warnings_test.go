@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestAttributeGetter(t *testing.T) {
+	t.Parallel()
+
+	get := attributeGetter(cty.ObjectVal(map[string]cty.Value{
+		"instance_type": cty.StringVal("t3.micro"),
+		"multi_az":      cty.True,
+		"size":          cty.NumberIntVal(8),
+		"tenancy":       cty.UnknownVal(cty.String),
+		"type":          cty.NullVal(cty.String),
+		"tags":          cty.MapValEmpty(cty.String),
+	}))
+
+	testCases := map[string]any{
+		"instance_type": "t3.micro",
+		"multi_az":      true,
+		"size":          8,
+		"tenancy":       nil,
+		"type":          nil,
+		"tags":          nil,
+		"missing":       nil,
+	}
+
+	for key, expected := range testCases {
+		if got := get(key); got != expected {
+			t.Errorf("get(%q) = %v, want %v", key, got, expected)
+		}
+	}
+}
+
+func TestAttributeChanged(t *testing.T) {
+	t.Parallel()
+
+	changed := attributeChanged(
+		cty.ObjectVal(map[string]cty.Value{
+			"instance_type": cty.StringVal("t3.micro"),
+			"tenancy":       cty.StringVal("default"),
+		}),
+		cty.ObjectVal(map[string]cty.Value{
+			"instance_type": cty.StringVal("t3.large"),
+			"tenancy":       cty.StringVal("default"),
+		}),
+	)
+
+	testCases := map[string]bool{
+		"instance_type": true,
+		"tenancy":       false,
+		"missing":       false,
+	}
+
+	for key, expected := range testCases {
+		if got := changed(key); got != expected {
+			t.Errorf("changed(%q) = %t, want %t", key, got, expected)
+		}
+	}
+}
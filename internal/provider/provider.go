@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -32,7 +34,7 @@ import (
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
-// The provider instance is fully configured once the `ConfigureContextFunc` has been called.
+// The provider instance is fully configured once the `ConfigureProvider` function has been called.
 func New(ctx context.Context) (*schema.Provider, error) {
 	log.Printf("Initializing Terraform AWS Provider...")
 
@@ -52,8 +54,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_rate_limit":                apiRateLimitSchema(),
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"cost_estimation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to report the estimated monthly cost of resources as warnings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monthly_threshold": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "The estimated monthly cost, in USD, at or above which a warning is reported.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -90,6 +109,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"emulator": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to run against a local AWS emulator.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+							Description:  "The AWS account ID reported by the emulator. Defaults to `" + conns.DefaultEmulatorAccountID + "`.",
+						},
+						"endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The base URL of the emulator, e.g. `http://localhost:4566`. Used for all service endpoints not configured in the `endpoints` block.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -164,6 +205,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry": apiRetrySchema(),
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -234,6 +276,87 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_drift": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to report resource tags changed outside Terraform as warnings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suggest_ignore_key_prefixes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							Description: "Whether the prefixes of tag keys added outside Terraform, up to and including the first `" + tftags.DriftKeyPrefixDelimiter + "`, " +
+								"are suggested for the provider's `ignore_tags` `key_prefixes`.",
+						},
+					},
+				},
+			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to enforce a tag policy across all resources that support tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(tftags.PolicyEnforcementError),
+							ValidateFunc: validation.StringInSlice(enum.Values[tftags.PolicyEnforcement](), false),
+							Description:  "Whether tag policy violations are reported as errors or warnings, or not checked. Valid values are `disabled`, `error` and `warning`.",
+						},
+						"key_case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(enum.Values[tftags.PolicyKeyCase](), false),
+							Description:  "Letter case required of tag keys. Valid values are `camel`, `kebab`, `lower`, `pascal`, `snake` and `upper`.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag keys that every resource that supports tags must have.",
+						},
+						"resource_enforcement": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(enum.Values[tftags.PolicyEnforcement](), false),
+							},
+							Description: "Map of resource type name to tag policy enforcement, overriding `enforcement`. Valid values are `disabled`, `error` and `warning`.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules for the values of a tag.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Values that the tag may have.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag key.",
+									},
+									"value_pattern": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the tag's value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -264,8 +387,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
-	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+	provider.ConfigureProvider = func(ctx context.Context, request schema.ConfigureProviderRequest, response *schema.ConfigureProviderResponse) {
+		// If the provider configuration is unknown, defer all resources and data sources instead of configuring with incomplete values.
+		if request.DeferralAllowed && providerConfigUnknown(request.ResourceData.GetRawConfig()) {
+			tflog.Info(ctx, "Provider configuration unknown, deferring")
+			response.Meta = provider.Meta()
+			response.Deferred = &schema.Deferred{
+				Reason: schema.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+
+		response.Meta, response.Diagnostics = configure(ctx, provider, request.ResourceData)
 	}
 
 	var errs []error
@@ -293,7 +426,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -303,6 +436,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if v.RegionOverride {
+				// The data source has opted in to per-resource region and IAM role overrides.
+				if !addOverrideSchema(r, true) {
+					errs = append(errs, fmt.Errorf("`%s` or `%s` attribute already defined in schema: %s", names.AttrRegion, attrAssumeRoleARN, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: overrideInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -369,7 +516,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -379,6 +526,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			override := v.RegionOverride
+			if override {
+				// The resource has opted in to per-resource region and IAM role overrides.
+				if !addOverrideSchema(r, false) {
+					errs = append(errs, fmt.Errorf("`%s` or `%s` attribute already defined in schema: %s", names.AttrRegion, attrAssumeRoleARN, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: overrideInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -414,9 +576,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if v.Identity != nil {
+				schema := r.SchemaMap()
+
+				// The resource has declared its structured resource identity.
+				// Ensure that the identifying attributes are in the schema.
+				identityAttributes := v.Identity.IdentifyingAttributes()
+				if i := slices.IndexFunc(identityAttributes, func(attr string) bool {
+					_, ok := schema[attr]
+					return !ok && attr != names.AttrID
+				}); i >= 0 {
+					errs = append(errs, fmt.Errorf("no `%s` identity attribute defined in schema: %s", identityAttributes[i], typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when: After,
+					why:  Read,
+					interceptor: identityInterceptor{
+						identity: v.Identity,
+					},
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
+				identity:         v.Identity,
 				interceptors:     interceptors,
+				override:         override,
+				tagPolicy:        v.Tags != nil,
+				typeName:         typeName,
+			}
+			if override {
+				rs.overrideComputedKeys = overrideComputedKeys(r)
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -436,7 +628,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
-			if v := r.CustomizeDiff; v != nil {
+			if v := r.CustomizeDiff; v != nil || override || rs.tagPolicy {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
 			for _, stateUpgrader := range r.StateUpgraders {
@@ -454,7 +646,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	}
 
 	// Set the provider Meta (instance data) here.
-	// It will be overwritten by the result of the call to ConfigureContextFunc,
+	// It will be overwritten by the result of the call to ConfigureProvider,
 	// but can be used pre-configuration by other (non-primary) provider servers.
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
@@ -481,6 +673,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APIAuditFile:                   os.Getenv(conns.APIAuditFileEnvVar),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -502,6 +695,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v, ok := d.GetOk("retry"); ok && len(v.([]any)) > 0 {
+		retries, dg := expandAPIRetries(ctx, cty.GetAttrPath("retry"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.APIRetries = retries
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -518,6 +720,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_rate_limit"); ok && len(v.([]any)) > 0 {
+		limits, dg := expandAPIRateLimits(ctx, cty.GetAttrPath("api_rate_limit"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.APIRateLimits = limits
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -565,6 +776,11 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("cost_estimation"); ok && len(v.([]interface{})) > 0 {
+		tfMap, _ := v.([]interface{})[0].(map[string]interface{})
+		config.CostEstimationConfig = expandCostEstimation(ctx, tfMap)
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -573,6 +789,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	if v, ok := d.GetOk("emulator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Emulator = expandEmulator(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -598,6 +818,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_drift"); ok && len(v.([]interface{})) > 0 {
+		tfMap, _ := v.([]interface{})[0].(map[string]interface{})
+		config.TagDriftConfig = expandTagDrift(ctx, tfMap)
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.TagPolicyConfig = expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -634,6 +863,76 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func apiRateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with settings to limit the rate and concurrency of AWS API calls per service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_concurrency": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of concurrent API calls.",
+				},
+				"operations": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "API operation names, e.g. `ChangeResourceRecordSets`, to limit. If not set, all of the service's operations are limited.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The maximum number of API requests per second, including retries.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, using the same names as the `endpoints` block, e.g. `route53`.",
+				},
+			},
+		},
+	}
+}
+
+func apiRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with settings to retry AWS API calls per service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Additional API error codes, e.g. `MalformedPolicyDocument`, to retry.",
+				},
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of attempts of each API call, including the initial call. Overrides `max_retries`.",
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+					Description:  "The maximum backoff delay between attempts, e.g. `30s`. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, using the same names as the `endpoints` block, e.g. `iam`.",
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -870,6 +1169,84 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func expandAPIRateLimits(_ context.Context, path cty.Path, tfList []any) ([]conns.APIRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObjects []conns.APIRateLimit
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.IndexInt(i).GetAttr("service"), "Invalid Service", err.Error()))
+			continue
+		}
+
+		apiObject := conns.APIRateLimit{
+			MaxConcurrency:    tfMap["max_concurrency"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			Service:           service,
+		}
+
+		if v, ok := tfMap["operations"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Operations = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func expandAPIRetries(_ context.Context, path cty.Path, tfList []any) ([]conns.APIRetry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObjects []conns.APIRetry
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.IndexInt(i).GetAttr("service"), "Invalid Service", err.Error()))
+			continue
+		}
+
+		apiObject := conns.APIRetry{
+			MaxAttempts: tfMap["max_attempts"].(int),
+			Service:     service,
+		}
+
+		if v, ok := tfMap["error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			apiObject.MaxBackoff = duration
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func expandCostEstimation(_ context.Context, tfMap map[string]interface{}) *conns.CostEstimationConfig {
+	costEstimationConfig := &conns.CostEstimationConfig{}
+
+	if v, ok := tfMap["monthly_threshold"].(float64); ok {
+		costEstimationConfig.MonthlyThreshold = v
+	}
+
+	return costEstimationConfig
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	tags := make(map[string]interface{})
 	for _, ev := range os.Environ() {
@@ -894,6 +1271,20 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
+func expandEmulator(_ context.Context, tfMap map[string]interface{}) *conns.EmulatorConfig {
+	emulatorConfig := &conns.EmulatorConfig{}
+
+	if v, ok := tfMap["account_id"].(string); ok {
+		emulatorConfig.AccountID = v
+	}
+
+	if v, ok := tfMap["endpoint"].(string); ok {
+		emulatorConfig.Endpoint = v
+	}
+
+	return emulatorConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
 
@@ -942,6 +1333,70 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandTagDrift(_ context.Context, tfMap map[string]interface{}) *tftags.DriftConfig {
+	driftConfig := &tftags.DriftConfig{}
+
+	if v, ok := tfMap["suggest_ignore_key_prefixes"].(bool); ok {
+		driftConfig.SuggestIgnoreKeyPrefixes = v
+	}
+
+	return driftConfig
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) *tftags.PolicyConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		policyConfig.Enforcement = tftags.PolicyEnforcement(v)
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		policyConfig.KeyCase = tftags.PolicyKeyCase(v)
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["resource_enforcement"].(map[string]interface{}); ok && len(v) > 0 {
+		policyConfig.ResourceEnforcement = make(map[string]tftags.PolicyEnforcement, len(v))
+		for k, v := range v {
+			policyConfig.ResourceEnforcement[k] = tftags.PolicyEnforcement(v.(string))
+		}
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			tag := tftags.PolicyTag{
+				Key: tfMap[names.AttrKey].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				tag.AllowedValues = flex.ExpandStringValueSet(v)
+				slices.Sort(tag.AllowedValues)
+			}
+
+			if v, ok := tfMap["value_pattern"].(string); ok && v != "" {
+				// Validated by the schema.
+				tag.ValuePattern = regexache.MustCompile(v)
+			}
+
+			policyConfig.Tags = append(policyConfig.Tags, tag)
+		}
+	}
+
+	return policyConfig
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
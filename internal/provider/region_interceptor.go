@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	attrAssumeRoleARN = "assume_role_arn"
+)
+
+// addOverrideSchema adds the per-resource `region` and `assume_role_arn` arguments to a resource or data source schema.
+// Nothing is added, and false is returned, if the schema already defines either attribute.
+func addOverrideSchema(r *schema.Resource, isDataSource bool) bool {
+	m := r.SchemaMap()
+	if _, ok := m[names.AttrRegion]; ok {
+		return false
+	}
+	if _, ok := m[attrAssumeRoleARN]; ok {
+		return false
+	}
+
+	// A resource without Update must replace itself when any argument changes.
+	hasUpdate := r.UpdateWithoutTimeout != nil || r.UpdateContext != nil || r.Update != nil
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			maps.Copy(m, overrideSchema(isDataSource, hasUpdate))
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		maps.Copy(r.Schema, overrideSchema(isDataSource, hasUpdate))
+	}
+
+	return true
+}
+
+func overrideSchema(isDataSource, hasUpdate bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		attrAssumeRoleARN: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     !isDataSource && !hasUpdate,
+			ValidateFunc: verify.ValidARN,
+			Description:  "ARN of an IAM role to assume when managing this resource, overriding the provider configuration.",
+		},
+		names.AttrRegion: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     !isDataSource,
+			ValidateFunc: verify.ValidRegionName,
+			Description:  "The region in which to manage this resource, overriding the provider configuration.",
+		},
+	}
+}
+
+// expandOverride returns any per-resource override configured in the specified resource data.
+func expandOverride(d interface{ Get(string) any }) conns.Override {
+	return conns.Override{
+		AssumeRoleARN: d.Get(attrAssumeRoleARN).(string),
+		Region:        d.Get(names.AttrRegion).(string),
+	}
+}
+
+// overrideMeta returns the provider Meta (instance data) for any per-resource override carried in Context.
+func overrideMeta(ctx context.Context, meta any) (any, error) {
+	o, ok := conns.OverrideFromContext(ctx)
+	if !ok {
+		return meta, nil
+	}
+
+	v, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return meta, nil
+	}
+
+	return v.WithOverride(ctx, o)
+}
+
+// overrideInterceptor implements per-resource region and IAM role overrides.
+// The override is placed in Context, and the effective region is set in state after CRU.
+type overrideInterceptor struct{}
+
+func (r overrideInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = conns.NewOverrideContext(ctx, expandOverride(d))
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// overrideCustomizeDiff plans the effective region when `region` is not configured.
+// If the provider's region has changed, the resource is replaced, as it would be without per-resource overrides.
+func overrideCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	region := meta.(*conns.AWSClient).Region
+	old, _ := d.GetChange(names.AttrRegion)
+
+	// Don't replace resources whose state predates per-resource overrides.
+	if d.Id() != "" && old.(string) == "" {
+		return nil
+	}
+
+	if old.(string) == region {
+		return nil
+	}
+
+	return d.SetNew(names.AttrRegion, region)
+}
+
+// overrideConfigUnknown returns whether the configured `region` or `assume_role_arn` is unknown.
+func overrideConfigUnknown(d *schema.ResourceDiff) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return false
+	}
+	if !config.IsKnown() {
+		return true
+	}
+
+	return !config.GetAttr(names.AttrRegion).IsKnown() || !config.GetAttr(attrAssumeRoleARN).IsKnown()
+}
+
+// overrideComputedKeys returns the keys of the resource's computed attributes whose values depend on the AWS account or Region in which it is managed.
+func overrideComputedKeys(r *schema.Resource) []string {
+	var keys []string
+
+	m := r.SchemaMap()
+	for _, key := range []string{names.AttrARN, names.AttrOwner, names.AttrOwnerID, names.AttrRegion, names.AttrURL} {
+		if v, ok := m[key]; ok && v.Computed {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// overrideUnknownCustomizeDiff plans the specified attributes as unknown when the configured `region` or `assume_role_arn` is unknown.
+// Nothing else is planned, as the AWS account and Region in which the resource is managed are not yet known.
+func overrideUnknownCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, keys []string) error {
+	tflog.Info(ctx, "Per-resource region or IAM role unknown, planning account and Region dependent attributes as unknown")
+
+	for _, key := range keys {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importOverride parses any `@<region>` suffix from an import ID.
+func importOverride(d *schema.ResourceData) error {
+	id, region, ok := cutLast(d.Id(), "@")
+	if !ok {
+		return nil
+	}
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		return nil
+	}
+
+	d.SetId(id)
+
+	return d.Set(names.AttrRegion, region)
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagDriftRead reports any resource tags changed outside Terraform since the resource was last read as a warning.
+// If so configured, the prefixes of tag keys added outside Terraform are suggested for the provider's ignore_tags key_prefixes.
+// The tags read must already exclude any ignored tags.
+func tagDriftRead(ctx context.Context, d schemaResourceData, tags tftags.KeyValueTags, serviceName, resourceName string, meta any, diags diag.Diagnostics) diag.Diagnostics {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return diags
+	}
+
+	driftConfig := c.TagDriftConfig(ctx)
+	if driftConfig == nil {
+		return diags
+	}
+
+	// Will occur on import.
+	state := d.GetRawState()
+	if state.IsNull() || !state.IsKnown() {
+		return diags
+	}
+	s := state.GetAttr(names.AttrTagsAll)
+	if s.IsNull() || !s.IsWhollyKnown() {
+		return diags
+	}
+
+	drift := tags.Drift(tftags.New(ctx, stringValueMap(s)).IgnoreConfig(c.IgnoreTagsConfig(ctx)))
+	if len(drift) == 0 {
+		return diags
+	}
+
+	// The configuration is not available during refresh, so the resource's tags in state stand in for its configured tags.
+	var configured tftags.KeyValueTags
+	if v := state.GetAttr(names.AttrTags); !v.IsNull() && v.IsWhollyKnown() {
+		configured = tftags.New(ctx, stringValueMap(v))
+	}
+	if defaultTagsConfig := c.DefaultTagsConfig(ctx); defaultTagsConfig != nil {
+		configured = configured.Merge(defaultTagsConfig.Tags)
+	}
+	prefixes := driftConfig.KeyPrefixSuggestions(drift, configured)
+
+	return append(diags, errs.NewWarningDiagnostic("Resource tags changed outside Terraform", tagDriftDetail(fmt.Sprintf("%s %s (%s)", serviceName, resourceName, d.Id()), drift, prefixes)))
+}
+
+func tagDriftDetail(resource string, drift []tftags.Drift, prefixes []string) string {
+	var detail strings.Builder
+
+	fmt.Fprintf(&detail, "%s tags changed outside Terraform:", resource)
+	for _, v := range drift {
+		fmt.Fprintf(&detail, "\n  - %s", v)
+	}
+
+	if len(prefixes) > 0 {
+		fmt.Fprintf(&detail, "\n\nTo stop reporting tags with these key prefixes, consider adding them to the provider's ignore_tags key_prefixes: %s.", strings.Join(prefixes, ", "))
+	}
+
+	return detail.String()
+}
+
+// stringValueMap returns the non-null elements of a known map of strings.
+func stringValueMap(v cty.Value) map[string]string {
+	m := make(map[string]string)
+	for k, v := range v.AsValueMap() {
+		if !v.IsNull() {
+			m[k] = v.AsString()
+		}
+	}
+
+	return m
+}
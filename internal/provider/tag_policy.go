@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyViolations returns any ways in which the resource's configured tags, merged with any provider configured default_tags,
+// violate the provider configured tag policy.
+// Tags with unknown values are checked for presence only. If the resource's tags are wholly unknown no check is made.
+func tagPolicyViolations(ctx context.Context, config cty.Value, meta *conns.AWSClient) []string {
+	policy := meta.TagPolicyConfig(ctx)
+	if policy == nil || config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	configTags := make(map[string]*string)
+	if c := config.GetAttr(names.AttrTags); !c.IsKnown() {
+		return nil
+	} else if !c.IsNull() {
+		for k, v := range c.AsValueMap() {
+			if v.IsNull() {
+				continue
+			}
+			if !v.IsKnown() {
+				configTags[k] = nil
+				continue
+			}
+			s := v.AsString()
+			configTags[k] = &s
+		}
+	}
+
+	return policy.Violations(meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, configTags)))
+}
+
+func tagPolicyViolationsDetail(typeName string, violations []string) string {
+	return fmt.Sprintf("%s tags violate the provider tag_policy:\n  - %s", typeName, strings.Join(violations, "\n  - "))
+}
+
+// tagPolicyCustomizeDiff fails the plan if the resource's tags violate the tag policy and the resource type's enforcement is `error`.
+func tagPolicyCustomizeDiff(ctx context.Context, typeName string, d *schema.ResourceDiff, meta any) error {
+	v, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	if v.TagPolicyConfig(ctx).EnforcementFor(typeName) != tftags.PolicyEnforcementError {
+		return nil
+	}
+
+	if violations := tagPolicyViolations(ctx, d.GetRawConfig(), v); len(violations) > 0 {
+		return fmt.Errorf("%s", tagPolicyViolationsDetail(typeName, violations))
+	}
+
+	return nil
+}
+
+// tagPolicyWarning returns any tag policy violations as a warning for resource types whose enforcement is `warning`.
+// Terraform Plugin SDK CustomizeDiff functions cannot return warnings, so violations are reported by the plan warnings provider server.
+func tagPolicyWarning(ctx context.Context, typeName string, meta *conns.AWSClient, config cty.Value) *tfprotov5.Diagnostic {
+	if meta.TagPolicyConfig(ctx).EnforcementFor(typeName) != tftags.PolicyEnforcementWarning {
+		return nil
+	}
+
+	violations := tagPolicyViolations(ctx, config, meta)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &tfprotov5.Diagnostic{
+		Severity:  tfprotov5.DiagnosticSeverityWarning,
+		Summary:   "Tag policy violation",
+		Detail:    tagPolicyViolationsDetail(typeName, violations),
+		Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTags),
+	}
+}
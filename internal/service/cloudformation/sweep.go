@@ -211,6 +211,11 @@ func sweepStacks(region string) error {
 	}
 	sweepResources := make([]sweep.Sweepable, 0)
 
+	dryRun, err := sweep.DryRun()
+	if err != nil {
+		return err
+	}
+
 	pages := cloudformation.NewListStacksPaginator(conn, input)
 
 	for pages.HasMorePages() {
@@ -227,17 +232,21 @@ func sweepStacks(region string) error {
 
 		for _, v := range page.StackSummaries {
 			name := aws.ToString(v.StackName)
-			inputU := &cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   aws.String(name),
-			}
 
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtection(ctx, inputU)
+			// Termination protection is left enabled in dry-run mode.
+			if !dryRun {
+				inputU := &cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(false),
+					StackName:                   aws.String(name),
+				}
+
+				log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
+				_, err := conn.UpdateTerminationProtection(ctx, inputU)
 
-			if err != nil {
-				log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
-				continue
+				if err != nil {
+					log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
+					continue
+				}
 			}
 
 			r := resourceStack()
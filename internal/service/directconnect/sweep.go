@@ -413,6 +413,11 @@ func sweepMacSecKeys(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	input := &directconnect.DescribeConnectionsInput{}
 	dxConn := client.DirectConnectClient(ctx)
 	// Clean up leaked Secrets Manager resources created by Direct Connect.
@@ -43,6 +43,11 @@ func sweepTables(region string) error {
 	input := &dynamodb.ListTablesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
+	dryRun, err := sweep.DryRun()
+	if err != nil {
+		return err
+	}
+
 	pages := dynamodb.NewListTablesPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -57,13 +62,16 @@ func sweepTables(region string) error {
 		}
 
 		for _, v := range page.TableNames {
-			_, err := conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
-				DeletionProtectionEnabled: aws.Bool(false),
-				TableName:                 aws.String(v),
-			})
-
-			if err != nil {
-				log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
+			// Deletion protection is left enabled in dry-run mode.
+			if !dryRun {
+				_, err := conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+					DeletionProtectionEnabled: aws.Bool(false),
+					TableName:                 aws.String(v),
+				})
+
+				if err != nil {
+					log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
+				}
 			}
 
 			r := resourceTable()
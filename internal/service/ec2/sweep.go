@@ -477,6 +477,11 @@ func sweepCapacityReservations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.EC2Client(ctx)
 
 	resp, err := conn.DescribeCapacityReservations(ctx, &ec2.DescribeCapacityReservationsInput{})
@@ -1021,6 +1026,11 @@ func sweepInstances(region string) error {
 	input := &ec2.DescribeInstancesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
+	dryRun, err := sweep.DryRun()
+	if err != nil {
+		return err
+	}
+
 	pages := ec2.NewDescribeInstancesPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -1043,15 +1053,23 @@ func sweepInstances(region string) error {
 					continue
 				}
 
-				if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
-					log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
+				// Stop protection is left enabled in dry-run mode.
+				if !dryRun {
+					if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
+						log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
+					}
 				}
 
 				r := resourceInstance()
 				d := r.Data(nil)
 				d.SetId(id)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepable := sweep.WithTags(sweep.NewSweepResource(r, d, client), keyValueTags(ctx, v.Tags).Map())
+				if v := v.LaunchTime; v != nil {
+					sweepable = sweep.WithCreationTime(sweepable, aws.ToTime(v))
+				}
+
+				sweepResources = append(sweepResources, sweepable)
 			}
 		}
 	}
@@ -1353,7 +1371,7 @@ func sweepNetworkInterfaces(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithTags(sweep.NewSweepResource(r, d, client), keyValueTags(ctx, v.TagSet).Map()))
 		}
 	}
 
@@ -1498,6 +1516,10 @@ func sweepRouteTables(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.EC2Client(ctx)
 	var sweeperErrs *multierror.Error
 	input := &ec2.DescribeRouteTablesInput{}
@@ -1601,6 +1623,10 @@ func sweepSecurityGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.EC2Client(ctx)
 	input := &ec2.DescribeSecurityGroupsInput{}
 
@@ -1824,7 +1850,7 @@ func sweepSubnets(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.SubnetId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithTags(sweep.NewSweepResource(r, d, client), keyValueTags(ctx, v.Tags).Map()))
 		}
 	}
 
@@ -2504,6 +2530,7 @@ func sweepVPCs(region string) error {
 	conn := client.EC2Client(ctx)
 	input := &ec2.DescribeVpcsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
+	vpcs := make(map[string]awstypes.Vpc)
 
 	pages := ec2.NewDescribeVpcsPaginator(conn, input)
 	for pages.HasMorePages() {
@@ -2524,14 +2551,79 @@ func sweepVPCs(region string) error {
 				continue
 			}
 
-			r := resourceVPC()
+			vpcs[aws.ToString(v.VpcId)] = v
+		}
+	}
+
+	// Any network interfaces and subnets remaining in the VPCs are swept together with them,
+	// so that each subnet is deleted only after its network interfaces, and each VPC only after its subnets.
+	networkInterfaceIDsBySubnetID := make(map[string][]string)
+
+	networkInterfacePages := ec2.NewDescribeNetworkInterfacesPaginator(conn, &ec2.DescribeNetworkInterfacesInput{})
+	for networkInterfacePages.HasMorePages() {
+		page, err := networkInterfacePages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("error listing EC2 Network Interfaces (%s): %w", region, err)
+		}
+
+		for _, v := range page.NetworkInterfaces {
+			if _, ok := vpcs[aws.ToString(v.VpcId)]; !ok || v.Status != awstypes.NetworkInterfaceStatusAvailable {
+				continue
+			}
+
+			id := aws.ToString(v.NetworkInterfaceId)
+			sweepableID := "aws_network_interface/" + id
+			subnetID := aws.ToString(v.SubnetId)
+			networkInterfaceIDsBySubnetID[subnetID] = append(networkInterfaceIDsBySubnetID[subnetID], sweepableID)
+
+			r := resourceNetworkInterface()
 			d := r.Data(nil)
-			d.SetId(aws.ToString(v.VpcId))
+			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepable := sweep.WithTags(sweep.NewSweepResource(r, d, client), keyValueTags(ctx, v.TagSet).Map())
+			sweepResources = append(sweepResources, sweep.NewDependentSweepable(sweepable, sweepableID))
+		}
+	}
+
+	subnetIDsByVPCID := make(map[string][]string)
+
+	subnetPages := ec2.NewDescribeSubnetsPaginator(conn, &ec2.DescribeSubnetsInput{})
+	for subnetPages.HasMorePages() {
+		page, err := subnetPages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("error listing EC2 Subnets (%s): %w", region, err)
+		}
+
+		for _, v := range page.Subnets {
+			vpcID := aws.ToString(v.VpcId)
+			if _, ok := vpcs[vpcID]; !ok {
+				continue
+			}
+
+			id := aws.ToString(v.SubnetId)
+			sweepableID := "aws_subnet/" + id
+			subnetIDsByVPCID[vpcID] = append(subnetIDsByVPCID[vpcID], sweepableID)
+
+			r := resourceSubnet()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepable := sweep.WithTags(sweep.NewSweepResource(r, d, client), keyValueTags(ctx, v.Tags).Map())
+			sweepResources = append(sweepResources, sweep.NewDependentSweepable(sweepable, sweepableID, networkInterfaceIDsBySubnetID[id]...))
 		}
 	}
 
+	for id, v := range vpcs {
+		r := resourceVPC()
+		d := r.Data(nil)
+		d.SetId(id)
+
+		sweepable := sweep.WithTags(sweep.NewSweepResource(r, d, client), keyValueTags(ctx, v.Tags).Map())
+		sweepResources = append(sweepResources, sweep.NewDependentSweepable(sweepable, "aws_vpc/"+id, subnetIDsByVPCID[id]...))
+	}
+
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
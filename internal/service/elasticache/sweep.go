@@ -92,6 +92,11 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	input := &elasticache.DescribeCacheClustersInput{
 		ShowCacheClustersNotInReplicationGroups: aws.Bool(true),
 	}
@@ -141,6 +146,11 @@ func sweepGlobalReplicationGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	input := &elasticache.DescribeGlobalReplicationGroupsInput{
 		ShowMemberInfo: aws.Bool(true),
 	}
@@ -491,6 +491,11 @@ func sweepSecurityConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.GlueClient(ctx)
 
 	input := &glue.GetSecurityConfigurationsInput{}
@@ -578,6 +583,11 @@ func sweepWorkflow(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.GlueClient(ctx)
 
 	listOutput, err := conn.ListWorkflows(ctx, &glue.ListWorkflowsInput{})
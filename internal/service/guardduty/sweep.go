@@ -36,6 +36,10 @@ func sweepDetectors(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.GuardDutyClient(ctx)
 	input := &guardduty.ListDetectorsInput{}
 	var sweeperErrs *multierror.Error
@@ -84,6 +88,10 @@ func sweepPublishingDestinations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.GuardDutyClient(ctx)
 	var sweeperErrs *multierror.Error
 
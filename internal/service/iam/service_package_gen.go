@@ -218,6 +218,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+				Global:     true,
+			},
 		},
 		{
 			Factory:  resourceRolePolicy,
@@ -120,6 +120,10 @@ func sweepGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.IAMClient(ctx)
 	input := &iam.ListGroupsInput{}
 	var sweeperErrs *multierror.Error
@@ -426,6 +430,11 @@ func sweepRoles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.IAMClient(ctx)
 
 	roles := make([]string, 0)
@@ -507,6 +516,11 @@ func sweepServerCertificates(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.IAMClient(ctx)
 
 	pages := iam.NewListServerCertificatesPaginator(conn, &iam.ListServerCertificatesInput{})
@@ -27,6 +27,7 @@ import (
 )
 
 // @FrameworkResource(name="Resource Policy")
+// @RegionOverride
 func newResourcePolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourcePolicyResource{}
 
@@ -213,6 +214,7 @@ func findResourcePolicyByARN(ctx context.Context, conn *kinesis.Client, resource
 }
 
 type resourcePolicyResourceModel struct {
+	framework.RegionOverrideModel
 	ID          types.String      `tfsdk:"id"`
 	Policy      fwtypes.IAMPolicy `tfsdk:"policy"`
 	ResourceARN fwtypes.ARN       `tfsdk:"resource_arn"`
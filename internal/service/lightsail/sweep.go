@@ -273,6 +273,11 @@ func sweepInstances(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.LightsailClient(ctx)
 
 	input := &lightsail.GetInstancesInput{}
@@ -365,6 +370,11 @@ func sweepStaticIPs(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.LightsailClient(ctx)
 
 	input := &lightsail.GetStaticIpsInput{}
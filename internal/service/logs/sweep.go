@@ -6,6 +6,7 @@ package logs
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
@@ -81,7 +82,12 @@ func sweepGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.LogGroupName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			var sweepable sweep.Sweepable = sweep.NewSweepResource(r, d, client)
+			if v := v.CreationTime; v != nil {
+				sweepable = sweep.WithCreationTime(sweepable, time.UnixMilli(aws.ToInt64(v)))
+			}
+
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	hoursPerMonth = 730
+
+	priceUnitGBMonth = "GB-Mo"
+	priceUnitHours   = "Hrs"
+)
+
+// CostEstimate is the estimated monthly on-demand cost, in USD, of a resource.
+type CostEstimate struct {
+	Description string
+	MonthlyCost float64
+	UnitPrice   float64
+	Unit        string
+}
+
+func (e *CostEstimate) String() string {
+	return fmt.Sprintf("%s at $%s per %s", e.Description, strconv.FormatFloat(e.UnitPrice, 'f', -1, 64), e.Unit)
+}
+
+// priceQuery identifies a single on-demand price in the AWS Price List Query API.
+type priceQuery struct {
+	description string
+	filters     map[string]string
+	quantity    float64 // Units per month.
+	serviceCode string
+	unit        string
+}
+
+func (q *priceQuery) key(region string) string {
+	var sb strings.Builder
+	sb.WriteString(q.serviceCode)
+	for _, k := range slices.Sorted(maps.Keys(q.filters)) {
+		fmt.Fprintf(&sb, "|%s=%s", k, q.filters[k])
+	}
+	fmt.Fprintf(&sb, "|regionCode=%s|unit=%s", region, q.unit)
+	return sb.String()
+}
+
+type costEstimator struct {
+	// attributes are the resource attributes that the estimate depends on.
+	attributes []string
+	// query returns the price query for the resource's planned attribute values.
+	// Returns false if no estimate can be made, e.g. if an attribute's value is unknown.
+	query func(get func(string) any) (*priceQuery, bool)
+}
+
+var costEstimators = map[string]costEstimator{
+	"aws_db_instance": {
+		attributes: []string{"engine", "instance_class", "multi_az"},
+		query: func(get func(string) any) (*priceQuery, bool) {
+			instanceClass, _ := get("instance_class").(string)
+			engine, ok := map[string]string{
+				"mariadb":  "MariaDB",
+				"mysql":    "MySQL",
+				"postgres": "PostgreSQL",
+			}[getString(get, "engine")]
+			if instanceClass == "" || !ok {
+				return nil, false
+			}
+			deploymentOption := "Single-AZ"
+			if v, _ := get("multi_az").(bool); v {
+				deploymentOption = "Multi-AZ"
+			}
+
+			return &priceQuery{
+				description: fmt.Sprintf("%s %s %s DB instance", instanceClass, deploymentOption, engine),
+				filters: map[string]string{
+					"databaseEngine":   engine,
+					"deploymentOption": deploymentOption,
+					"instanceType":     instanceClass,
+				},
+				quantity:    hoursPerMonth,
+				serviceCode: "AmazonRDS",
+				unit:        priceUnitHours,
+			}, true
+		},
+	},
+	"aws_ebs_volume": {
+		attributes: []string{names.AttrSize, names.AttrType},
+		query: func(get func(string) any) (*priceQuery, bool) {
+			size, _ := get(names.AttrSize).(int)
+			if size <= 0 {
+				return nil, false
+			}
+			volumeType := getString(get, names.AttrType)
+			if volumeType == "" {
+				volumeType = "gp2"
+			}
+
+			return &priceQuery{
+				description: fmt.Sprintf("%d GiB %s EBS volume storage", size, volumeType),
+				filters: map[string]string{
+					"productFamily": "Storage",
+					"volumeApiName": volumeType,
+				},
+				quantity:    float64(size),
+				serviceCode: "AmazonEC2",
+				unit:        priceUnitGBMonth,
+			}, true
+		},
+	},
+	"aws_instance": {
+		attributes: []string{names.AttrInstanceType, "tenancy"},
+		query: func(get func(string) any) (*priceQuery, bool) {
+			instanceType := getString(get, names.AttrInstanceType)
+			if instanceType == "" {
+				return nil, false
+			}
+			tenancy := "Shared"
+			switch getString(get, "tenancy") {
+			case "dedicated":
+				tenancy = "Dedicated"
+			case "host":
+				tenancy = "Host"
+			}
+
+			return &priceQuery{
+				description: fmt.Sprintf("%s %s tenancy Linux EC2 instance", instanceType, strings.ToLower(tenancy)),
+				filters: map[string]string{
+					"capacitystatus":  "Used",
+					"instanceType":    instanceType,
+					"licenseModel":    "No License required",
+					"operatingSystem": "Linux",
+					"preInstalledSw":  "NA",
+					"tenancy":         tenancy,
+				},
+				quantity:    hoursPerMonth,
+				serviceCode: "AmazonEC2",
+				unit:        priceUnitHours,
+			}, true
+		},
+	},
+	"aws_nat_gateway": {
+		query: func(get func(string) any) (*priceQuery, bool) {
+			return &priceQuery{
+				description: "NAT gateway hours",
+				filters: map[string]string{
+					"productFamily": "NAT Gateway",
+				},
+				quantity:    hoursPerMonth,
+				serviceCode: "AmazonEC2",
+				unit:        priceUnitHours,
+			}, true
+		},
+	},
+}
+
+func getString(get func(string) any, key string) string {
+	v, _ := get(key).(string)
+	return v
+}
+
+// CostEstimateSupported returns whether the monthly cost of resources of the specified type can be estimated.
+func CostEstimateSupported(typeName string) bool {
+	_, ok := costEstimators[typeName]
+	return ok
+}
+
+// CostEstimateChanged returns whether any attribute that the specified resource type's cost estimate depends on has changed.
+func CostEstimateChanged(typeName string, hasChange func(string) bool) bool {
+	return slices.ContainsFunc(costEstimators[typeName].attributes, hasChange)
+}
+
+// priceCacheEntry is a cached unit price.
+// Its lock is held while the price is retrieved, so that each price is retrieved once without blocking the retrieval of other prices.
+type priceCacheEntry struct {
+	sync.Mutex
+	cached bool
+	// price is nil if no price was found.
+	price *float64
+}
+
+var (
+	// priceCacheLock guards priceCache, not the entries in it.
+	priceCacheLock sync.Mutex
+	// priceCache caches the unit prices retrieved for the lifetime of the provider process, keyed by query.
+	priceCache = make(map[string]*priceCacheEntry)
+)
+
+// EstimateMonthlyCost returns the estimated monthly on-demand cost, in USD, of a resource of the specified type in the specified Region.
+// `get` returns the resource's planned attribute values.
+// Returns nil if no estimate can be made.
+// Only the main usage-independent component of each resource's cost is estimated, e.g. EC2 instance hours but not EBS volumes or data transfer.
+func EstimateMonthlyCost(ctx context.Context, conn *pricing.Client, typeName, region string, get func(string) any) (*CostEstimate, error) {
+	estimator, ok := costEstimators[typeName]
+	if !ok {
+		return nil, nil
+	}
+
+	query, ok := estimator.query(get)
+	if !ok {
+		return nil, nil
+	}
+
+	priceListRegion, ok := priceListRegion(region)
+	if !ok {
+		return nil, nil
+	}
+
+	price, err := cachedUnitPrice(ctx, conn, query, region, priceListRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	if price == nil {
+		return nil, nil
+	}
+
+	return &CostEstimate{
+		Description: query.description,
+		MonthlyCost: *price * query.quantity,
+		UnitPrice:   *price,
+		Unit:        query.unit,
+	}, nil
+}
+
+func cachedUnitPrice(ctx context.Context, conn *pricing.Client, query *priceQuery, region, priceListRegion string) (*float64, error) {
+	key := query.key(region)
+
+	priceCacheLock.Lock()
+	entry, ok := priceCache[key]
+	if !ok {
+		entry = &priceCacheEntry{}
+		priceCache[key] = entry
+	}
+	priceCacheLock.Unlock()
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	if entry.cached {
+		return entry.price, nil
+	}
+
+	price, err := findUnitPrice(ctx, conn, query, region, priceListRegion)
+
+	// Errors aren't cached, so that the next estimate retries.
+	if err != nil {
+		return nil, err
+	}
+
+	entry.cached = true
+	entry.price = price
+
+	return price, nil
+}
+
+func findUnitPrice(ctx context.Context, conn *pricing.Client, query *priceQuery, region, priceListRegion string) (*float64, error) {
+	input := &pricing.GetProductsInput{
+		Filters: []awstypes.Filter{{
+			Field: aws.String("regionCode"),
+			Type:  awstypes.FilterTypeTermMatch,
+			Value: aws.String(region),
+		}},
+		FormatVersion: aws.String("aws_v1"),
+		ServiceCode:   aws.String(query.serviceCode),
+	}
+	for _, k := range slices.Sorted(maps.Keys(query.filters)) {
+		input.Filters = append(input.Filters, awstypes.Filter{
+			Field: aws.String(k),
+			Type:  awstypes.FilterTypeTermMatch,
+			Value: aws.String(query.filters[k]),
+		})
+	}
+
+	optFn := func(o *pricing.Options) {
+		o.Region = priceListRegion
+	}
+
+	var price *float64
+	pages := pricing.NewGetProductsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFn)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading Pricing Products (%s): %w", query.serviceCode, err)
+		}
+
+		for _, v := range page.PriceList {
+			p, err := onDemandUnitPrice(v, query.unit)
+			if err != nil {
+				return nil, err
+			}
+
+			// Use the highest matching price.
+			if p != nil && (price == nil || *p > *price) {
+				price = p
+			}
+		}
+	}
+
+	return price, nil
+}
+
+// priceList is the subset of an AWS Price List product document used to estimate costs.
+type priceList struct {
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+				Unit         string            `json:"unit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// onDemandUnitPrice returns the highest non-zero on-demand USD price for the specified unit in the AWS Price List product document.
+func onDemandUnitPrice(document, unit string) (*float64, error) {
+	var v priceList
+	if err := json.Unmarshal([]byte(document), &v); err != nil {
+		return nil, fmt.Errorf("parsing Pricing Product: %w", err)
+	}
+
+	var price *float64
+	for _, term := range v.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			if dimension.Unit != unit {
+				continue
+			}
+
+			p, err := strconv.ParseFloat(dimension.PricePerUnit["USD"], 64)
+			if err != nil || p <= 0 {
+				continue
+			}
+
+			if price == nil || p > *price {
+				price = aws.Float64(p)
+			}
+		}
+	}
+
+	return price, nil
+}
+
+// priceListRegion returns the Region of the AWS Price List Query API endpoint for the specified Region.
+// Returns false if the API is not available in the Region's partition.
+// See https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/using-price-list-query-api.html#price-list-query-api-endpoints.
+func priceListRegion(region string) (string, bool) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return "", false
+	}
+
+	switch partition.ID() {
+	case endpoints.AwsPartitionID:
+		return endpoints.UsEast1RegionID, true
+	case endpoints.AwsCnPartitionID:
+		return endpoints.CnNorthwest1RegionID, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOnDemandUnitPrice(t *testing.T) {
+	t.Parallel()
+
+	const document = `{
+  "product": {"productFamily": "Compute Instance", "attributes": {"instanceType": "t3.large"}},
+  "terms": {
+    "OnDemand": {
+      "ABC.JRTCKXETXF": {
+        "priceDimensions": {
+          "ABC.JRTCKXETXF.6YS6EN2CT7": {"unit": "Hrs", "pricePerUnit": {"USD": "0.0832000000"}},
+          "ABC.JRTCKXETXF.ZERO": {"unit": "Hrs", "pricePerUnit": {"USD": "0.0000000000"}},
+          "ABC.JRTCKXETXF.GB": {"unit": "GB", "pricePerUnit": {"USD": "0.0450000000"}}
+        }
+      }
+    }
+  }
+}`
+
+	testCases := map[string]struct {
+		document  string
+		unit      string
+		expected  *float64
+		expectErr bool
+	}{
+		"hours": {
+			document: document,
+			unit:     priceUnitHours,
+			expected: aws.Float64(0.0832),
+		},
+		"GB": {
+			document: document,
+			unit:     "GB",
+			expected: aws.Float64(0.045),
+		},
+		"no matching unit": {
+			document: document,
+			unit:     priceUnitGBMonth,
+		},
+		"invalid document": {
+			document:  `{`,
+			unit:      priceUnitHours,
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := onDemandUnitPrice(testCase.document, testCase.unit)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Fatalf("onDemandUnitPrice() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCostEstimatorQuery(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName           string
+		attributes         map[string]any
+		expectedFilters    map[string]string
+		expectedQuantity   float64
+		expectedNoEstimate bool
+	}{
+		"instance": {
+			typeName: "aws_instance",
+			attributes: map[string]any{
+				"instance_type": "t3.large",
+				"tenancy":       "default",
+			},
+			expectedFilters: map[string]string{
+				"capacitystatus":  "Used",
+				"instanceType":    "t3.large",
+				"licenseModel":    "No License required",
+				"operatingSystem": "Linux",
+				"preInstalledSw":  "NA",
+				"tenancy":         "Shared",
+			},
+			expectedQuantity: hoursPerMonth,
+		},
+		"instance type unknown": {
+			typeName:           "aws_instance",
+			attributes:         map[string]any{},
+			expectedNoEstimate: true,
+		},
+		"EBS volume default type": {
+			typeName: "aws_ebs_volume",
+			attributes: map[string]any{
+				"size": 100,
+			},
+			expectedFilters: map[string]string{
+				"productFamily": "Storage",
+				"volumeApiName": "gp2",
+			},
+			expectedQuantity: 100,
+		},
+		"DB instance Multi-AZ": {
+			typeName: "aws_db_instance",
+			attributes: map[string]any{
+				"engine":         "postgres",
+				"instance_class": "db.m5.large",
+				"multi_az":       true,
+			},
+			expectedFilters: map[string]string{
+				"databaseEngine":   "PostgreSQL",
+				"deploymentOption": "Multi-AZ",
+				"instanceType":     "db.m5.large",
+			},
+			expectedQuantity: hoursPerMonth,
+		},
+		"DB instance unsupported engine": {
+			typeName: "aws_db_instance",
+			attributes: map[string]any{
+				"engine":         "oracle-ee",
+				"instance_class": "db.m5.large",
+			},
+			expectedNoEstimate: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query, ok := costEstimators[testCase.typeName].query(func(key string) any {
+				return testCase.attributes[key]
+			})
+
+			if got, want := !ok, testCase.expectedNoEstimate; got != want {
+				t.Fatalf("no estimate %t, want %t", got, want)
+			}
+			if !ok {
+				return
+			}
+
+			if diff := cmp.Diff(query.filters, testCase.expectedFilters); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if got, want := query.quantity, testCase.expectedQuantity; got != want {
+				t.Errorf("quantity %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCostEstimateChanged(t *testing.T) {
+	t.Parallel()
+
+	hasChange := func(keys ...string) func(string) bool {
+		return func(key string) bool {
+			for _, k := range keys {
+				if k == key {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	if !CostEstimateChanged("aws_instance", hasChange("instance_type")) {
+		t.Error("expected instance_type change to change the estimate")
+	}
+	if CostEstimateChanged("aws_instance", hasChange("tags")) {
+		t.Error("expected tags change not to change the estimate")
+	}
+	if CostEstimateChanged("aws_nat_gateway", hasChange("subnet_id")) {
+		t.Error("expected no NAT gateway change to change the estimate")
+	}
+}
+
+func TestPriceListRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"cn-north-1":     "cn-northwest-1",
+		"cn-northwest-1": "cn-northwest-1",
+		"eu-west-1":      "us-east-1",
+		"us-gov-west-1":  "",
+	}
+
+	for region, expected := range testCases {
+		if got, _ := priceListRegion(region); got != expected {
+			t.Errorf("priceListRegion(%q) = %q, want %q", region, got, expected)
+		}
+	}
+}
@@ -253,7 +253,12 @@ func sweepClusters(region string) error {
 				}
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepable := sweep.WithTags(sweep.NewSweepResource(r, d, client), KeyValueTags(ctx, v.TagList).Map())
+			if v := v.ClusterCreateTime; v != nil {
+				sweepable = sweep.WithCreationTime(sweepable, aws.ToTime(v))
+			}
+
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
@@ -383,7 +388,12 @@ func sweepInstances(region string) error {
 			d.Set(names.AttrIdentifier, v.DBInstanceIdentifier)
 			d.Set("skip_final_snapshot", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepable := sweep.WithTags(sweep.NewSweepResource(r, d, client), KeyValueTags(ctx, v.TagList).Map())
+			if v := v.InstanceCreateTime; v != nil {
+				sweepable = sweep.WithCreationTime(sweepable, aws.ToTime(v))
+			}
+
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
@@ -664,6 +674,10 @@ func sweepInstanceAutomatedBackups(region string) error {
 		return fmt.Errorf("error sweeping RDS Instance Automated Backups (%s): %w", region, err)
 	}
 
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	// Since there is no resource for automated backups themselves, they are swept here.
 	for _, v := range backupARNs {
 		log.Printf("[DEBUG] Deleting RDS Instance Automated Backup: %s", v)
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Import is an AWS resource that can be imported into Terraform state.
+type Import struct {
+	// ARN is the resource's ARN.
+	ARN string
+	// ID is the resource's import ID.
+	ID string
+	// To is the resource address to import into.
+	To string
+	// TypeName is the provider resource type.
+	TypeName string
+}
+
+// importIDFunc returns the import ID of a resource from its ARN and the ARN's resource with the matched prefix removed.
+// An empty import ID indicates that the resource cannot be imported.
+type importIDFunc func(a arn.ARN, resourceID string) string
+
+func importIDARN(a arn.ARN, _ string) string {
+	return a.String()
+}
+
+func importIDResourceID(_ arn.ARN, resourceID string) string {
+	return resourceID
+}
+
+// importIDResourceName returns the last path component of the resource ID, e.g. the name of an IAM role with a path.
+func importIDResourceName(_ arn.ARN, resourceID string) string {
+	return resourceID[strings.LastIndex(resourceID, "/")+1:]
+}
+
+// importIDAPIGateway returns the ID of an API Gateway API.
+// Nested resources, e.g. stages, are not supported.
+func importIDAPIGateway(_ arn.ARN, resourceID string) string {
+	if strings.Contains(resourceID, "/") {
+		return ""
+	}
+
+	return resourceID
+}
+
+// importIDLogGroup returns the name of a CloudWatch Logs log group.
+// Log group ARNs may have a trailing `:*`.
+func importIDLogGroup(_ arn.ARN, resourceID string) string {
+	return strings.TrimSuffix(resourceID, ":*")
+}
+
+// importTarget is the provider resource type that manages AWS resources whose ARNs match a prefix.
+type importTarget struct {
+	typeName string
+	// If importID is nil, the import ID is the ARN if the resource type's transparent tagging identifier attribute is `arn`,
+	// and the resource ID otherwise.
+	importID importIDFunc
+}
+
+// importTargets maps `<service>:<resource prefix>` ARN prefixes to provider resource types.
+// The longest matching prefix is used.
+var importTargets = map[string]importTarget{
+	"acm:certificate/":                       {typeName: "aws_acm_certificate"},
+	"apigateway:/apis/":                      {typeName: "aws_apigatewayv2_api", importID: importIDAPIGateway},
+	"apigateway:/restapis/":                  {typeName: "aws_api_gateway_rest_api", importID: importIDAPIGateway},
+	"athena:workgroup/":                      {typeName: "aws_athena_workgroup", importID: importIDResourceID},
+	"backup:backup-vault:":                   {typeName: "aws_backup_vault", importID: importIDResourceID},
+	"cloudfront:distribution/":               {typeName: "aws_cloudfront_distribution", importID: importIDResourceID},
+	"cloudwatch:alarm:":                      {typeName: "aws_cloudwatch_metric_alarm", importID: importIDResourceID},
+	"cognito-idp:userpool/":                  {typeName: "aws_cognito_user_pool", importID: importIDResourceID},
+	"dynamodb:table/":                        {typeName: "aws_dynamodb_table", importID: importIDResourceID},
+	"ec2:customer-gateway/":                  {typeName: "aws_customer_gateway"},
+	"ec2:dhcp-options/":                      {typeName: "aws_vpc_dhcp_options"},
+	"ec2:egress-only-internet-gateway/":      {typeName: "aws_egress_only_internet_gateway"},
+	"ec2:elastic-ip/":                        {typeName: "aws_eip"},
+	"ec2:image/":                             {typeName: "aws_ami"},
+	"ec2:instance/":                          {typeName: "aws_instance"},
+	"ec2:internet-gateway/":                  {typeName: "aws_internet_gateway"},
+	"ec2:launch-template/":                   {typeName: "aws_launch_template"},
+	"ec2:natgateway/":                        {typeName: "aws_nat_gateway"},
+	"ec2:network-acl/":                       {typeName: "aws_network_acl"},
+	"ec2:network-interface/":                 {typeName: "aws_network_interface"},
+	"ec2:prefix-list/":                       {typeName: "aws_ec2_managed_prefix_list"},
+	"ec2:route-table/":                       {typeName: "aws_route_table"},
+	"ec2:security-group/":                    {typeName: "aws_security_group"},
+	"ec2:snapshot/":                          {typeName: "aws_ebs_snapshot"},
+	"ec2:subnet/":                            {typeName: "aws_subnet"},
+	"ec2:transit-gateway/":                   {typeName: "aws_ec2_transit_gateway"},
+	"ec2:volume/":                            {typeName: "aws_ebs_volume"},
+	"ec2:vpc-endpoint/":                      {typeName: "aws_vpc_endpoint"},
+	"ec2:vpc-peering-connection/":            {typeName: "aws_vpc_peering_connection"},
+	"ec2:vpc/":                               {typeName: "aws_vpc"},
+	"ec2:vpn-gateway/":                       {typeName: "aws_vpn_gateway"},
+	"ecr:repository/":                        {typeName: "aws_ecr_repository", importID: importIDResourceID},
+	"ecs:cluster/":                           {typeName: "aws_ecs_cluster", importID: importIDResourceID},
+	"ecs:task-definition/":                   {typeName: "aws_ecs_task_definition"},
+	"eks:cluster/":                           {typeName: "aws_eks_cluster", importID: importIDResourceID},
+	"elasticache:cluster:":                   {typeName: "aws_elasticache_cluster", importID: importIDResourceID},
+	"elasticfilesystem:access-point/":        {typeName: "aws_efs_access_point"},
+	"elasticfilesystem:file-system/":         {typeName: "aws_efs_file_system"},
+	"elasticloadbalancing:listener-rule/":    {typeName: "aws_lb_listener_rule"},
+	"elasticloadbalancing:listener/":         {typeName: "aws_lb_listener"},
+	"elasticloadbalancing:loadbalancer/":     {typeName: "aws_elb"},
+	"elasticloadbalancing:loadbalancer/app/": {typeName: "aws_lb"},
+	"elasticloadbalancing:loadbalancer/net/": {typeName: "aws_lb"},
+	"elasticloadbalancing:targetgroup/":      {typeName: "aws_lb_target_group"},
+	"elasticmapreduce:cluster/":              {typeName: "aws_emr_cluster"},
+	"es:domain/":                             {typeName: "aws_opensearch_domain", importID: importIDResourceID},
+	"events:rule/":                           {typeName: "aws_cloudwatch_event_rule", importID: importIDResourceID},
+	"firehose:deliverystream/":               {typeName: "aws_kinesis_firehose_delivery_stream", importID: importIDARN},
+	"glue:job/":                              {typeName: "aws_glue_job", importID: importIDResourceID},
+	"iam:policy/":                            {typeName: "aws_iam_policy"},
+	"iam:role/":                              {typeName: "aws_iam_role", importID: importIDResourceName},
+	"iam:user/":                              {typeName: "aws_iam_user", importID: importIDResourceName},
+	"kinesis:stream/":                        {typeName: "aws_kinesis_stream"},
+	"kms:key/":                               {typeName: "aws_kms_key"},
+	"lambda:function:":                       {typeName: "aws_lambda_function", importID: importIDResourceID},
+	"logs:log-group:":                        {typeName: "aws_cloudwatch_log_group", importID: importIDLogGroup},
+	"rds:cluster:":                           {typeName: "aws_rds_cluster", importID: importIDResourceID},
+	"rds:db:":                                {typeName: "aws_db_instance", importID: importIDResourceID},
+	"redshift:cluster:":                      {typeName: "aws_redshift_cluster", importID: importIDResourceID},
+	"route53:hostedzone/":                    {typeName: "aws_route53_zone"},
+	"s3:":                                    {typeName: "aws_s3_bucket", importID: importIDResourceID},
+	"secretsmanager:secret:":                 {typeName: "aws_secretsmanager_secret"},
+	"sns:":                                   {typeName: "aws_sns_topic"},
+	"states:stateMachine:":                   {typeName: "aws_sfn_state_machine", importID: importIDARN},
+}
+
+// findImportTarget returns the provider resource type managing the AWS resource with the specified ARN,
+// and the ARN's resource with the matched prefix removed.
+func findImportTarget(a arn.ARN) (importTarget, string, bool) {
+	s := a.Service + ":" + a.Resource
+
+	var prefix string
+	for k := range importTargets {
+		if strings.HasPrefix(s, k) && len(k) > len(prefix) {
+			prefix = k
+		}
+	}
+
+	if prefix == "" {
+		return importTarget{}, "", false
+	}
+
+	return importTargets[prefix], strings.TrimPrefix(s, prefix), true
+}
+
+// resourceIdentifierAttributes returns the transparent tagging identifier attribute of each registered provider resource type.
+// Resource types that don't support transparent tagging have an empty identifier attribute.
+func resourceIdentifierAttributes(ctx context.Context, servicePackages map[string]conns.ServicePackage) map[string]string {
+	m := make(map[string]string)
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			m[v.TypeName] = ""
+			if v.Tags != nil {
+				m[v.TypeName] = v.Tags.IdentifierAttribute
+			}
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			inner, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+			metadataResponse := resource.MetadataResponse{}
+			inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+			m[metadataResponse.TypeName] = ""
+			if v.Tags != nil {
+				m[metadataResponse.TypeName] = v.Tags.IdentifierAttribute
+			}
+		}
+	}
+
+	return m
+}
+
+// FindImports returns the resources matching the specified input that can be imported into Terraform state,
+// and the ARNs of any matching resources that are not managed by a known provider resource type.
+func FindImports(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput, servicePackages map[string]conns.ServicePackage) ([]Import, []string, error) {
+	identifierAttributes := resourceIdentifierAttributes(ctx, servicePackages)
+
+	var imports []Import
+	var unsupported []string
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			resourceARN := aws.ToString(v.ResourceARN)
+
+			if v, ok := newImport(resourceARN, identifierAttributes); ok {
+				imports = append(imports, v)
+			} else {
+				unsupported = append(unsupported, resourceARN)
+			}
+		}
+	}
+
+	setImportAddresses(imports)
+
+	return imports, unsupported, nil
+}
+
+func newImport(resourceARN string, identifierAttributes map[string]string) (Import, bool) {
+	a, err := arn.Parse(resourceARN)
+	if err != nil {
+		return Import{}, false
+	}
+
+	target, resourceID, ok := findImportTarget(a)
+	if !ok || resourceID == "" {
+		return Import{}, false
+	}
+
+	identifierAttribute, ok := identifierAttributes[target.typeName]
+	if !ok {
+		return Import{}, false
+	}
+
+	importID := target.importID
+	if importID == nil {
+		if identifierAttribute == names.AttrARN {
+			importID = importIDARN
+		} else {
+			importID = importIDResourceID
+		}
+	}
+
+	id := importID(a, resourceID)
+	if id == "" {
+		return Import{}, false
+	}
+
+	return Import{
+		ARN:      resourceARN,
+		ID:       id,
+		TypeName: target.typeName,
+	}, true
+}
+
+// setImportAddresses sets a unique resource address, derived from the import ID, for each import.
+func setImportAddresses(imports []Import) {
+	slices.SortFunc(imports, func(a, b Import) int {
+		if n := strings.Compare(a.TypeName, b.TypeName); n != 0 {
+			return n
+		}
+		if n := strings.Compare(a.ID, b.ID); n != 0 {
+			return n
+		}
+		return strings.Compare(a.ARN, b.ARN)
+	})
+
+	seen := make(map[string]int)
+	for i, v := range imports {
+		name := importResourceName(v.ID)
+		address := v.TypeName + "." + name
+
+		seen[address]++
+		if n := seen[address]; n > 1 {
+			address = fmt.Sprintf("%s_%d", address, n)
+		}
+
+		imports[i].To = address
+	}
+}
+
+// importResourceName returns a valid Terraform resource name derived from an import ID.
+func importResourceName(id string) string {
+	if i := strings.LastIndex(id, "/"); i >= 0 && i < len(id)-1 {
+		id = id[i+1:]
+	}
+	if i := strings.LastIndex(id, ":"); i >= 0 && i < len(id)-1 {
+		id = id[i+1:]
+	}
+
+	name := strings.Trim(regexache.MustCompile(`[^0-9a-z]+`).ReplaceAllString(strings.ToLower(id), "_"), "_")
+
+	switch {
+	case name == "":
+		return "resource"
+	case name[0] >= '0' && name[0] <= '9':
+		return "r_" + name
+	}
+
+	return name
+}
+
+// ImportBlocks returns Terraform configuration containing an `import` block for each import.
+func ImportBlocks(imports []Import) string {
+	var b strings.Builder
+
+	for i, v := range imports {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "# %s\n", v.ARN)
+		b.WriteString("import {\n")
+		fmt.Fprintf(&b, "  to = %s\n", v.To)
+		fmt.Fprintf(&b, "  id = %s\n", hclQuote(v.ID))
+		b.WriteString("}\n")
+	}
+
+	return b.String()
+}
+
+// hclQuote returns a quoted HCL string literal, escaping any template sequences.
+func hclQuote(s string) string {
+	s = strings.ReplaceAll(s, "${", "$${")
+	s = strings.ReplaceAll(s, "%{", "%%{")
+
+	return fmt.Sprintf("%q", s)
+}
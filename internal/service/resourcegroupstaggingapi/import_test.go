@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewImport(t *testing.T) {
+	t.Parallel()
+
+	identifierAttributes := map[string]string{
+		"aws_cloudwatch_log_group": names.AttrARN,
+		"aws_iam_role":             names.AttrName,
+		"aws_lambda_function":      names.AttrARN,
+		"aws_lb":                   names.AttrARN,
+		"aws_elb":                  names.AttrID,
+		"aws_s3_bucket":            names.AttrARN,
+		"aws_api_gateway_rest_api": names.AttrARN,
+		"aws_vpc":                  names.AttrID,
+	}
+
+	testCases := []struct {
+		name       string
+		arn        string
+		wantImport Import
+		wantOK     bool
+	}{
+		{
+			name: "invalid ARN",
+			arn:  "vpc-0123456789abcdef0",
+		},
+		{
+			name: "unknown service",
+			arn:  "arn:aws:unknown:us-west-2:123456789012:thing/abc", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name: "unregistered resource type",
+			arn:  "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name: "resource ID",
+			arn:  "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0", //lintignore:AWSAT003,AWSAT005
+			wantImport: Import{
+				ARN:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0", //lintignore:AWSAT003,AWSAT005
+				ID:       "vpc-0123456789abcdef0",
+				TypeName: "aws_vpc",
+			},
+			wantOK: true,
+		},
+		{
+			name: "ARN identifier attribute",
+			arn:  "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+			wantImport: Import{
+				ARN:      "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+				ID:       "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+				TypeName: "aws_lb",
+			},
+			wantOK: true,
+		},
+		{
+			name: "longest prefix",
+			arn:  "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/my-classic-lb", //lintignore:AWSAT003,AWSAT005
+			wantImport: Import{
+				ARN:      "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/my-classic-lb", //lintignore:AWSAT003,AWSAT005
+				ID:       "my-classic-lb",
+				TypeName: "aws_elb",
+			},
+			wantOK: true,
+		},
+		{
+			name: "global resource",
+			arn:  "arn:aws:s3:::my-bucket", //lintignore:AWSAT005
+			wantImport: Import{
+				ARN:      "arn:aws:s3:::my-bucket", //lintignore:AWSAT005
+				ID:       "my-bucket",
+				TypeName: "aws_s3_bucket",
+			},
+			wantOK: true,
+		},
+		{
+			name: "resource name",
+			arn:  "arn:aws:iam::123456789012:role/service-role/my-role", //lintignore:AWSAT005
+			wantImport: Import{
+				ARN:      "arn:aws:iam::123456789012:role/service-role/my-role", //lintignore:AWSAT005
+				ID:       "my-role",
+				TypeName: "aws_iam_role",
+			},
+			wantOK: true,
+		},
+		{
+			name: "colon separator",
+			arn:  "arn:aws:lambda:us-west-2:123456789012:function:my-function", //lintignore:AWSAT003,AWSAT005
+			wantImport: Import{
+				ARN:      "arn:aws:lambda:us-west-2:123456789012:function:my-function", //lintignore:AWSAT003,AWSAT005
+				ID:       "my-function",
+				TypeName: "aws_lambda_function",
+			},
+			wantOK: true,
+		},
+		{
+			name: "log group",
+			arn:  "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/my-function:*", //lintignore:AWSAT003,AWSAT005
+			wantImport: Import{
+				ARN:      "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/my-function:*", //lintignore:AWSAT003,AWSAT005
+				ID:       "/aws/lambda/my-function",
+				TypeName: "aws_cloudwatch_log_group",
+			},
+			wantOK: true,
+		},
+		{
+			name: "nested resource",
+			arn:  "arn:aws:apigateway:us-west-2::/restapis/a1b2c3/stages/prod", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := newImport(testCase.arn, identifierAttributes)
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Fatalf("ok = %t; want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.wantImport); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestImportBlocks(t *testing.T) {
+	t.Parallel()
+
+	imports := []Import{
+		{
+			ARN:      "arn:aws:iam::123456789012:role/my-role", //lintignore:AWSAT005
+			ID:       "my-role",
+			TypeName: "aws_iam_role",
+		},
+		{
+			ARN:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0", //lintignore:AWSAT003,AWSAT005
+			ID:       "vpc-0123456789abcdef0",
+			TypeName: "aws_vpc",
+		},
+		{
+			ARN:      "arn:aws:iam::123456789012:role/path/My.Role", //lintignore:AWSAT005
+			ID:       "My.Role",
+			TypeName: "aws_iam_role",
+		},
+		{
+			ARN:      "arn:aws:iam::123456789012:role/other-path/my-role", //lintignore:AWSAT005
+			ID:       "my-role",
+			TypeName: "aws_iam_role",
+		},
+		{
+			ARN:      "arn:aws:s3:::123-${bucket}", //lintignore:AWSAT005
+			ID:       "123-${bucket}",
+			TypeName: "aws_s3_bucket",
+		},
+	}
+
+	setImportAddresses(imports)
+
+	got := ImportBlocks(imports)
+	want := `# arn:aws:iam::123456789012:role/path/My.Role
+import {
+  to = aws_iam_role.my_role
+  id = "My.Role"
+}
+
+# arn:aws:iam::123456789012:role/my-role
+import {
+  to = aws_iam_role.my_role_2
+  id = "my-role"
+}
+
+# arn:aws:iam::123456789012:role/other-path/my-role
+import {
+  to = aws_iam_role.my_role_3
+  id = "my-role"
+}
+
+# arn:aws:s3:::123-${bucket}
+import {
+  to = aws_s3_bucket.r_123_bucket
+  id = "123-$${bucket}"
+}
+
+# arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0
+import {
+  to = aws_vpc.vpc_0123456789abcdef0
+  id = "vpc-0123456789abcdef0"
+}
+` //lintignore:AWSAT003,AWSAT005
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
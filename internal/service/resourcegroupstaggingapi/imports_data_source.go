@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_resourcegroupstaggingapi_imports", name="Imports")
+func dataSourceImports() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"import_blocks": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_type_filters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tag_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"unsupported_resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceImportsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.ResourceGroupsTaggingAPIClient(ctx)
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.TagFilters = expandTagFilters(v.([]interface{}))
+	}
+
+	imports, unsupported, err := FindImports(ctx, conn, input, c.ServicePackages)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources: %s", err)
+	}
+
+	d.SetId(c.Region)
+	d.Set("import_blocks", ImportBlocks(imports))
+	if err := d.Set("imports", flattenImports(imports)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting imports: %s", err)
+	}
+	d.Set("unsupported_resource_arns", unsupported)
+
+	return diags
+}
+
+func flattenImports(imports []Import) []interface{} {
+	tfList := make([]interface{}, 0, len(imports))
+
+	for _, v := range imports {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID:          v.ID,
+			names.AttrResourceARN: v.ARN,
+			"to":                  v.To,
+			names.AttrType:        v.TypeName,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPIImportsDataSource_tagFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_imports.test"
+	vpcResourceName := "aws_vpc.test"
+	subnetResourceName := "aws_subnet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportsDataSourceConfig_tagFilter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "imports.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "imports.*", map[string]string{
+						names.AttrType: "aws_subnet",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "imports.*.id", subnetResourceName, names.AttrID),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "imports.*", map[string]string{
+						names.AttrType: "aws_vpc",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "imports.*.id", vpcResourceName, names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "imports.*.resource_arn", vpcResourceName, names.AttrARN),
+					resource.TestMatchResourceAttr(dataSourceName, "import_blocks", regexache.MustCompile(`to = aws_vpc\.vpc_[0-9a-f]+`)),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_resource_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIImportsDataSource_resourceTypeFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_imports.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportsDataSourceConfig_resourceTypeFilters(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "imports.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "imports.0.type", "aws_vpc"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "imports.0.id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccImportsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.0.1.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccImportsDataSourceConfig_tagFilter(rName string) string {
+	return acctest.ConfigCompose(testAccImportsDataSourceConfig_base(rName), `
+data "aws_resourcegroupstaggingapi_imports" "test" {
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+
+  depends_on = [aws_subnet.test]
+}
+`)
+}
+
+func testAccImportsDataSourceConfig_resourceTypeFilters(rName string) string {
+	return acctest.ConfigCompose(testAccImportsDataSourceConfig_base(rName), `
+data "aws_resourcegroupstaggingapi_imports" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+
+  depends_on = [aws_subnet.test]
+}
+`)
+}
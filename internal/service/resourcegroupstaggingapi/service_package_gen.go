@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceImports,
+			TypeName: "aws_resourcegroupstaggingapi_imports",
+			Name:     "Imports",
+		},
 		{
 			Factory:  dataSourceResources,
 			TypeName: "aws_resourcegroupstaggingapi_resources",
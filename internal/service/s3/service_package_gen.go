@@ -88,6 +88,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrBucket},
+			},
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
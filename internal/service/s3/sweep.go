@@ -185,7 +185,12 @@ func sweepBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 			d := r.Data(nil)
 			d.SetId(aws.ToString(bucket.Name))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			var sweepable sweep.Sweepable = sdk.NewSweepResource(r, d, client)
+			if v := bucket.CreationDate; v != nil {
+				sweepable = sweep.WithCreationTime(sweepable, aws.ToTime(v))
+			}
+
+			sweepResources = append(sweepResources, sweepable)
 		}
 	}
 
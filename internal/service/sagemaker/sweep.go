@@ -471,6 +471,11 @@ func sweepEndpoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.SageMakerClient(ctx)
 
 	input := &sagemaker.ListEndpointsInput{
@@ -1004,6 +1009,11 @@ func sweepProjects(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.SageMakerClient(ctx)
 	var sweeperErrs *multierror.Error
 
@@ -46,6 +46,11 @@ func sweepConfigurationSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.SESClient(ctx)
 	input := &ses.ListConfigurationSetsInput{}
 	var sweeperErrs *multierror.Error
@@ -94,6 +99,11 @@ func sweepIdentities(region, identityType string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.SESClient(ctx)
 	input := &ses.ListIdentitiesInput{
 		IdentityType: awstypes.IdentityType(identityType),
@@ -139,6 +149,11 @@ func sweepReceiptRuleSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
+
+	if skip, err := sweep.SkipDirectDeletion(ctx); skip || err != nil {
+		return err
+	}
+
 	conn := client.SESClient(ctx)
 
 	// You cannot delete the receipt rule set that is currently active.
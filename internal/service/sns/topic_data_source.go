@@ -23,6 +23,7 @@ import (
 // @SDKDataSource("aws_sns_topic")
 // @Testing(tagsTest=true)
 // @Tags(identifierAttribute="arn")
+// @RegionOverride
 func dataSourceTopic() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceTopicRead,
@@ -24,6 +24,7 @@ import (
 
 // @SDKDataSource("aws_sqs_queue")
 // @Tags(identifierAttribute="url")
+// @RegionOverride
 func dataSourceQueue() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceQueueRead,
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
-	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
@@ -17,7 +16,7 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 		Name: name,
 		F: func(region string) error {
 			ctx := sweep.Context(region)
-			ctx = log.WithResourceType(ctx, name)
+			ctx = sweep.WithResourceType(ctx, name)
 
 			client, err := sweep.SharedRegionalSweepClient(ctx, region)
 			if err != nil {
@@ -10,6 +10,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKey int
+
+const (
+	regionContextKey contextKey = iota
+	resourceTypeContextKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +24,26 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+// WithResourceType returns a copy of the sweeper context for the specified resource type.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	region, _ := ctx.Value(regionContextKey).(string)
+
+	return region
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	resourceType, _ := ctx.Value(resourceTypeContextKey).(string)
+
+	return resourceType
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,6 +50,21 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// ID returns the identifying attributes of the resource to be deleted.
+func (sr *sweepResource) ID() string {
+	ids := make([]string, 0, len(sr.attributes))
+
+	for _, attr := range sr.attributes {
+		if attr.path == names.AttrID {
+			return fmt.Sprint(attr.value)
+		}
+
+		ids = append(ids, fmt.Sprintf("%s=%v", attr.path, attr.value))
+	}
+
+	return strings.Join(ids, ",")
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 
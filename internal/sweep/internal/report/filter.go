@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Filter selects the resources to sweep.
+// The zero value selects every resource.
+type Filter struct {
+	// Tags that a resource must have, with matching values. An empty value matches any value.
+	Tags map[string]string
+	// MinAge is the minimum time since a resource was created.
+	MinAge time.Duration
+}
+
+// ParseTags parses a comma-separated list of key=value pairs.
+// A key without "=value" matches any value of that tag.
+func ParseTags(s string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(v, "=")
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag filter %q: missing key", v)
+		}
+
+		tags[key] = strings.TrimSpace(value)
+	}
+
+	return tags, nil
+}
+
+// IsEmpty returns whether the filter selects every resource.
+func (f Filter) IsEmpty() bool {
+	return len(f.Tags) == 0 && f.MinAge == 0
+}
+
+// Match returns whether the filter selects the resource described by the specified entry.
+// Resources whose tags or creation time are unknown are not selected by a filter that relies on them.
+func (f Filter) Match(e Entry, now time.Time) bool {
+	for k, v := range f.Tags {
+		value, ok := e.Tags[k]
+		if !ok || (v != "" && value != v) {
+			return false
+		}
+	}
+
+	if f.MinAge > 0 {
+		if e.CreatedAt == nil || now.Sub(*e.CreatedAt) < f.MinAge {
+			return false
+		}
+	}
+
+	return true
+}
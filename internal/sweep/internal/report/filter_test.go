@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    map[string]string
+		expectError bool
+	}{
+		"empty": {
+			input:    "",
+			expected: map[string]string{},
+		},
+		"key value pairs": {
+			input:    "Env=test, Owner = sweeper",
+			expected: map[string]string{"Env": "test", "Owner": "sweeper"},
+		},
+		"key only": {
+			input:    "Ephemeral,Env=test",
+			expected: map[string]string{"Ephemeral": "", "Env": "test"},
+		},
+		"missing key": {
+			input:       "=test",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTags(testCase.input)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParseTags(%q) err %t, want %t", testCase.input, got, want)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	testCases := map[string]struct {
+		filter   Filter
+		entry    Entry
+		expected bool
+	}{
+		"empty filter": {
+			entry:    Entry{ID: "a"},
+			expected: true,
+		},
+		"tag value match": {
+			filter:   Filter{Tags: map[string]string{"Env": "test"}},
+			entry:    Entry{Tags: map[string]string{"Env": "test", "Name": "a"}},
+			expected: true,
+		},
+		"tag value mismatch": {
+			filter: Filter{Tags: map[string]string{"Env": "test"}},
+			entry:  Entry{Tags: map[string]string{"Env": "prod"}},
+		},
+		"tag key only": {
+			filter:   Filter{Tags: map[string]string{"Env": ""}},
+			entry:    Entry{Tags: map[string]string{"Env": "prod"}},
+			expected: true,
+		},
+		"tags unknown": {
+			filter: Filter{Tags: map[string]string{"Env": ""}},
+			entry:  Entry{},
+		},
+		"old enough": {
+			filter:   Filter{MinAge: 24 * time.Hour},
+			entry:    Entry{CreatedAt: &old},
+			expected: true,
+		},
+		"too recent": {
+			filter: Filter{MinAge: 24 * time.Hour},
+			entry:  Entry{CreatedAt: &recent},
+		},
+		"creation time unknown": {
+			filter: Filter{MinAge: 24 * time.Hour},
+			entry:  Entry{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.Match(testCase.entry, now), testCase.expected; got != want {
+				t.Errorf("Match() = %t, want %t", got, want)
+			}
+		})
+	}
+}
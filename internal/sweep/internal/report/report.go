@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusDeleted Status = "deleted"
+	StatusDryRun  Status = "dry-run"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+// Entry describes a resource that a sweeper deleted, or would have deleted.
+type Entry struct {
+	Type      string            `json:"type"`
+	ID        string            `json:"id"`
+	Region    string            `json:"region"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	Status    Status            `json:"status"`
+	Error     string            `json:"error,omitempty"`
+}
+
+var csvHeader = []string{"type", "id", "region", "created_at", "tags", "status", "error"}
+
+func (e Entry) csvRecord() []string {
+	var createdAt string
+	if e.CreatedAt != nil {
+		createdAt = e.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	tags := make([]string, 0, len(e.Tags))
+	for _, k := range slices.Sorted(maps.Keys(e.Tags)) {
+		tags = append(tags, k+"="+e.Tags[k])
+	}
+
+	return []string{e.Type, e.ID, e.Region, createdAt, strings.Join(tags, ";"), string(e.Status), e.Error}
+}
+
+// Writer writes report entries as JSON Lines or CSV.
+// It is safe for concurrent use.
+type Writer struct {
+	mutex   sync.Mutex
+	format  Format
+	header  bool
+	encoder *json.Encoder
+	csv     *csv.Writer
+}
+
+// NewWriter returns a Writer for the specified format.
+// If header is true a CSV header row is written before the first entry.
+func NewWriter(w io.Writer, format Format, header bool) (*Writer, error) {
+	writer := &Writer{
+		format: format,
+		header: header,
+	}
+
+	switch format {
+	case FormatCSV:
+		writer.csv = csv.NewWriter(w)
+	case FormatJSON:
+		writer.encoder = json.NewEncoder(w)
+	default:
+		return nil, fmt.Errorf("unsupported report format: %q", format)
+	}
+
+	return writer, nil
+}
+
+// Write writes a single report entry.
+func (w *Writer) Write(e Entry) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	switch w.format {
+	case FormatCSV:
+		if w.header {
+			if err := w.csv.Write(csvHeader); err != nil {
+				return err
+			}
+			w.header = false
+		}
+
+		if err := w.csv.Write(e.csvRecord()); err != nil {
+			return err
+		}
+
+		w.csv.Flush()
+
+		return w.csv.Error()
+	default:
+		return w.encoder.Encode(e)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	entries := []Entry{
+		{
+			Type:      "aws_vpc",
+			ID:        "vpc-12345678",
+			Region:    "us-west-2",
+			CreatedAt: &createdAt,
+			Tags:      map[string]string{"Name": "tf-acc-test", "Env": "test"},
+			Status:    StatusDryRun,
+		},
+		{
+			Type:   "aws_subnet",
+			ID:     "subnet-12345678",
+			Region: "us-west-2",
+			Status: StatusFailed,
+			Error:  "DependencyViolation",
+		},
+	}
+
+	testCases := map[string]struct {
+		format   Format
+		header   bool
+		expected string
+	}{
+		"json": {
+			format: FormatJSON,
+			expected: `{"type":"aws_vpc","id":"vpc-12345678","region":"us-west-2","created_at":"2024-01-02T03:04:05Z","tags":{"Env":"test","Name":"tf-acc-test"},"status":"dry-run"}
+{"type":"aws_subnet","id":"subnet-12345678","region":"us-west-2","status":"failed","error":"DependencyViolation"}
+`,
+		},
+		"csv with header": {
+			format: FormatCSV,
+			header: true,
+			expected: `type,id,region,created_at,tags,status,error
+aws_vpc,vpc-12345678,us-west-2,2024-01-02T03:04:05Z,Env=test;Name=tf-acc-test,dry-run,
+aws_subnet,subnet-12345678,us-west-2,,,failed,DependencyViolation
+`,
+		},
+		"csv without header": {
+			format: FormatCSV,
+			expected: `aws_vpc,vpc-12345678,us-west-2,2024-01-02T03:04:05Z,Env=test;Name=tf-acc-test,dry-run,
+aws_subnet,subnet-12345678,us-west-2,,,failed,DependencyViolation
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var sb strings.Builder
+			w, err := NewWriter(&sb, testCase.format, testCase.header)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, e := range entries {
+				if err := w.Write(e); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if got, want := sb.String(), testCase.expected; got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestNewWriterInvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+	if _, err := NewWriter(&sb, Format("yaml"), false); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// deletionLog records the order in which testSweepables are deleted.
+type deletionLog struct {
+	mutex sync.Mutex
+	ids   []string
+}
+
+func (l *deletionLog) append(id string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.ids = append(l.ids, id)
+}
+
+type testSweepable struct {
+	id   string
+	err  error
+	log  *deletionLog
+	wait time.Duration
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	time.Sleep(s.wait)
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.log.append(s.id)
+
+	return nil
+}
+
+func TestSweepOrchestrator_waves(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	log := &deletionLog{}
+
+	// Dependencies are slower to delete, so that any deletion that doesn't wait for them is recorded first.
+	sweepables := []sweep.Sweepable{
+		sweep.NewDependentSweepable(testSweepable{id: "vpc", log: log}, "aws_vpc/vpc", "aws_subnet/subnet1", "aws_subnet/subnet2"),
+		sweep.NewDependentSweepable(testSweepable{id: "subnet1", log: log, wait: 10 * time.Millisecond}, "aws_subnet/subnet1", "aws_network_interface/eni"),
+		sweep.NewDependentSweepable(testSweepable{id: "subnet2", log: log, wait: 10 * time.Millisecond}, "aws_subnet/subnet2", "aws_network_interface/other-sweep"),
+		sweep.NewDependentSweepable(testSweepable{id: "eni", log: log, wait: 20 * time.Millisecond}, "aws_network_interface/eni"),
+		testSweepable{id: "unrelated", log: log},
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(log.ids), len(sweepables); got != want {
+		t.Fatalf("deleted %d, want %d: %v", got, want, log.ids)
+	}
+
+	index := func(id string) int {
+		return slices.Index(log.ids, id)
+	}
+
+	for _, v := range [][2]string{
+		{"eni", "subnet1"},
+		{"subnet1", "vpc"},
+		{"subnet2", "vpc"},
+	} {
+		if index(v[0]) > index(v[1]) {
+			t.Errorf("%s deleted after %s: %v", v[0], v[1], log.ids)
+		}
+	}
+}
+
+func TestSweepOrchestrator_failedDependency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	log := &deletionLog{}
+
+	sweepables := []sweep.Sweepable{
+		sweep.NewDependentSweepable(testSweepable{id: "vpc", log: log}, "aws_vpc/vpc", "aws_subnet/subnet"),
+		sweep.NewDependentSweepable(testSweepable{id: "subnet", log: log}, "aws_subnet/subnet", "aws_network_interface/eni"),
+		sweep.NewDependentSweepable(testSweepable{id: "eni", log: log, err: errors.New("DependencyViolation")}, "aws_network_interface/eni"),
+		sweep.NewDependentSweepable(testSweepable{id: "other-subnet", log: log}, "aws_subnet/other-subnet"),
+	}
+
+	err := sweep.SweepOrchestrator(ctx, sweepables)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	// The failed network interface's subnet and, in turn, its VPC are skipped.
+	if got, want := log.ids, []string{"other-subnet"}; !slices.Equal(got, want) {
+		t.Errorf("deleted %v, want %v", got, want)
+	}
+}
+
+func TestSweepOrchestrator_dependencyCycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	log := &deletionLog{}
+
+	sweepables := []sweep.Sweepable{
+		sweep.NewDependentSweepable(testSweepable{id: "a", log: log}, "a", "b"),
+		sweep.NewDependentSweepable(testSweepable{id: "b", log: log}, "b", "a"),
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepables); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(log.ids) > 0 {
+		t.Errorf("deleted %v, want none", log.ids)
+	}
+}
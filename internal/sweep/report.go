@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+)
+
+// reportConfig controls filtering, dry-run and reporting for all sweepers run by the current process.
+// It is read once from the environment:
+//
+//   - TF_AWS_SWEEP_DRY_RUN: report the resources that would be deleted without deleting them
+//   - TF_AWS_SWEEP_REPORT_FILE: append a report of swept resources to this file, as CSV if the name ends in ".csv" and JSON Lines otherwise
+//   - TF_AWS_SWEEP_TAGS: only sweep resources with these comma-separated key=value tags
+//   - TF_AWS_SWEEP_MIN_AGE: only sweep resources created at least this long ago, e.g. "24h"
+type reportConfig struct {
+	dryRun bool
+	filter report.Filter
+	writer *report.Writer
+}
+
+var loadReportConfig = sync.OnceValues(func() (*reportConfig, error) {
+	config := &reportConfig{}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		config.dryRun = dryRun
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		tags, err := report.ParseTags(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepTags, err)
+		}
+		config.filter.Tags = tags
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		config.filter.MinAge = minAge
+	}
+
+	if v := os.Getenv(envvar.SweepReportFile); v != "" {
+		format := report.FormatJSON
+		if strings.EqualFold(filepath.Ext(v), ".csv") {
+			format = report.FormatCSV
+		}
+
+		// The file is shared by every sweeper and region run by this process and is closed on exit.
+		f, err := os.OpenFile(v, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("opening sweeper report file: %w", err)
+		}
+
+		fi, err := f.Stat()
+		if err != nil {
+			return nil, fmt.Errorf("opening sweeper report file: %w", err)
+		}
+
+		config.writer, err = report.NewWriter(f, format, fi.Size() == 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+})
+
+// DryRun returns whether dry-run mode is configured, in which case sweepers must not modify any resources.
+func DryRun() (bool, error) {
+	config, err := loadReportConfig()
+
+	if err != nil {
+		return false, err
+	}
+
+	return config.dryRun, nil
+}
+
+// SkipDirectDeletion returns whether a sweeper that deletes resources directly, rather than via SweepOrchestrator,
+// must not run because dry-run mode or tag or age filters are configured. Only SweepOrchestrator honours them.
+func SkipDirectDeletion(ctx context.Context) (bool, error) {
+	config, err := loadReportConfig()
+
+	if err != nil {
+		return false, err
+	}
+
+	if config.dryRun || !config.filter.IsEmpty() {
+		tflog.Warn(ctx, "Skipping sweeper that deletes resources directly in dry-run mode or with tag or age filters")
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// selected returns the Sweepables selected by the configured tag and age filters,
+// along with the IDs of the annotated Sweepables that were excluded.
+func (c *reportConfig) selected(ctx context.Context, sweepables []Sweepable) ([]Sweepable, map[string]struct{}) {
+	excluded := make(map[string]struct{})
+
+	if c.filter.IsEmpty() {
+		return sweepables, excluded
+	}
+
+	now := time.Now()
+	selected := make([]Sweepable, 0, len(sweepables))
+
+	for _, sweepable := range sweepables {
+		annotated := annotate(sweepable)
+		entry := describe(ctx, annotated)
+
+		if !c.filter.Match(entry, now) {
+			tflog.Debug(ctx, "Resource excluded by sweeper filters", map[string]any{
+				"id": entry.ID,
+			})
+			if annotated.id != "" {
+				excluded[annotated.id] = struct{}{}
+			}
+			continue
+		}
+
+		selected = append(selected, sweepable)
+	}
+
+	return selected, excluded
+}
+
+// report logs and, if configured, records the outcome of sweeping a single resource.
+func (c *reportConfig) report(ctx context.Context, sweepable *annotatedSweepable, status report.Status, err error) error {
+	entry := describe(ctx, sweepable)
+	entry.Status = status
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	if status == report.StatusDryRun {
+		tflog.Info(ctx, "Would sweep resource", map[string]any{
+			"id":   entry.ID,
+			"tags": entry.Tags,
+		})
+	}
+
+	if c.writer == nil {
+		return nil
+	}
+
+	if err := c.writer.Write(entry); err != nil {
+		return fmt.Errorf("writing sweeper report: %w", err)
+	}
+
+	return nil
+}
+
+// describe returns a report entry for the specified Sweepable.
+func describe(ctx context.Context, sweepable *annotatedSweepable) report.Entry {
+	entry := report.Entry{
+		Type:      resourceTypeFromContext(ctx),
+		ID:        sweepable.id,
+		Region:    regionFromContext(ctx),
+		CreatedAt: sweepable.createdAt,
+		Tags:      sweepable.tags,
+	}
+
+	if v, ok := sweepable.Sweepable.(interface{ ID() string }); ok {
+		entry.ID = v.ID()
+	}
+
+	return entry
+}
@@ -39,6 +39,11 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// ID returns the ID of the resource to be deleted.
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
@@ -5,9 +5,12 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -15,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -106,20 +111,205 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// annotatedSweepable is a Sweepable with additional information used to order, filter and report on sweeping.
+type annotatedSweepable struct {
+	Sweepable
+	id           string
+	dependencies []string
+	createdAt    *time.Time
+	tags         map[string]string
+}
+
+func annotate(sweepable Sweepable) *annotatedSweepable {
+	if v, ok := sweepable.(*annotatedSweepable); ok {
+		annotated := *v
+		return &annotated
+	}
+
+	return &annotatedSweepable{
+		Sweepable: sweepable,
+	}
+}
+
+// NewDependentSweepable returns a Sweepable, identified by id, that SweepOrchestrator deletes only after
+// the Sweepables identified by dependencies have been deleted successfully.
+// For example, a VPC depends on its subnets, which in turn depend on their network interfaces.
+// Dependencies that are not part of the same sweep are ignored.
+func NewDependentSweepable(sweepable Sweepable, id string, dependencies ...string) Sweepable {
+	annotated := annotate(sweepable)
+	annotated.id = id
+	annotated.dependencies = dependencies
+
+	return annotated
+}
+
+// WithTags returns a Sweepable annotated with the resource's tags, used for filtering and reporting.
+func WithTags(sweepable Sweepable, tags map[string]string) Sweepable {
+	annotated := annotate(sweepable)
+	annotated.tags = tags
+
+	return annotated
+}
+
+// WithCreationTime returns a Sweepable annotated with the resource's creation time, used for filtering and reporting.
+func WithCreationTime(sweepable Sweepable, createdAt time.Time) Sweepable {
+	annotated := annotate(sweepable)
+	annotated.createdAt = &createdAt
+
+	return annotated
+}
+
+// SweepOrchestrator deletes the specified Sweepables.
+// Sweepables are deleted concurrently in topological waves: a Sweepable created by NewDependentSweepable
+// is deleted in a later wave than all of its dependencies, and is skipped if any of them could not be deleted.
+// Sweepables that don't match the configured tag and age filters are left alone and,
+// in dry-run mode, nothing is deleted. See reportConfig.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	config, err := loadReportConfig()
+
+	if err != nil {
+		return err
+	}
+
+	sweepables, excluded := config.selected(ctx, sweepables)
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
-	var g multierror.Group
+	waves, err := sweepWaves(sweepables)
+
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+	failed := make(map[string]struct{})
+
+	for i, wave := range waves {
+		if len(waves) > 1 {
+			tflog.Info(ctx, "Sweeping wave", map[string]any{
+				"wave":  i + 1,
+				"waves": len(waves),
+				"count": len(wave),
+			})
+		}
+
+		// Skip anything whose dependencies failed in an earlier wave or were excluded by filters.
+		runnable := make([]*annotatedSweepable, 0, len(wave))
+		for _, node := range wave {
+			if slices.ContainsFunc(node.dependencies, func(dependency string) bool {
+				_, ok := failed[dependency]
+				return ok
+			}) {
+				failed[node.id] = struct{}{}
+				err := fmt.Errorf("skipping %s: one or more dependencies were not swept", node.id)
+				errs = multierror.Append(errs, err)
+				errs = multierror.Append(errs, config.report(ctx, node, report.StatusSkipped, err))
+				continue
+			}
+
+			if slices.ContainsFunc(node.dependencies, func(dependency string) bool {
+				_, ok := excluded[dependency]
+				return ok
+			}) {
+				excluded[node.id] = struct{}{}
+				errs = multierror.Append(errs, config.report(ctx, node, report.StatusSkipped, errors.New("one or more dependencies excluded by filters")))
+				continue
+			}
+
+			runnable = append(runnable, node)
+		}
+
+		var g multierror.Group
+		var mutex sync.Mutex
+
+		for _, node := range runnable {
+			g.Go(func() error {
+				if config.dryRun {
+					return config.report(ctx, node, report.StatusDryRun, nil)
+				}
+
+				err := node.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+
+				if err != nil {
+					mutex.Lock()
+					defer mutex.Unlock()
+					failed[node.id] = struct{}{}
+
+					return errors.Join(err, config.report(ctx, node, report.StatusFailed, err))
+				}
+
+				return config.report(ctx, node, report.StatusDeleted, nil)
+			})
+		}
+
+		errs = multierror.Append(errs, g.Wait())
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sweepWaves groups the specified Sweepables into waves that can be deleted concurrently.
+func sweepWaves(sweepables []Sweepable) ([][]*annotatedSweepable, error) {
+	nodes := make([]*annotatedSweepable, len(sweepables))
+	hasDependencies := false
+
+	for i, sweepable := range sweepables {
+		nodes[i] = annotate(sweepable)
+		hasDependencies = hasDependencies || len(nodes[i].dependencies) > 0
+	}
+
+	if !hasDependencies {
+		return [][]*annotatedSweepable{nodes}, nil
+	}
+
+	// Sweepables without an ID can't be depended on; give them a unique graph key.
+	keys := make([]string, len(nodes))
+	g := depgraph.New()
+	byID := make(map[string]*annotatedSweepable, len(nodes))
+
+	for i, node := range nodes {
+		key := node.id
+		if key == "" {
+			key = fmt.Sprintf("#%d", i)
+		}
+		keys[i] = key
+
+		if _, ok := byID[key]; ok {
+			return nil, fmt.Errorf("duplicate sweepable ID: %s", key)
+		}
+
+		byID[key] = node
+		g.AddNode(key)
+	}
+
+	for i, node := range nodes {
+		for _, dependency := range node.dependencies {
+			if _, ok := byID[dependency]; !ok {
+				continue
+			}
 
-	for _, sweepable := range sweepables {
-		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
-		})
+			if err := g.AddDependency(keys[i], dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	ids, err := g.Waves()
+
+	if err != nil {
+		return nil, err
+	}
+
+	waves := make([][]*annotatedSweepable, len(ids))
+	for i, wave := range ids {
+		for _, id := range wave {
+			waves[i] = append(waves[i], byID[id])
+		}
 	}
 
-	return g.Wait().ErrorOrNil()
+	return waves, nil
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+const (
+	// DriftKeyPrefixDelimiter terminates the prefix of tag keys added outside Terraform that can be suggested for ignoring.
+	DriftKeyPrefixDelimiter = ":"
+)
+
+// DriftConfig contains settings for reporting resource tags changed outside Terraform.
+type DriftConfig struct {
+	// SuggestIgnoreKeyPrefixes is set if the prefixes of tag keys added outside Terraform are suggested as ignore_tags key_prefixes.
+	SuggestIgnoreKeyPrefixes bool
+}
+
+type DriftAction string
+
+const (
+	DriftActionAdded   DriftAction = "added"
+	DriftActionChanged DriftAction = "changed"
+	DriftActionRemoved DriftAction = "removed"
+)
+
+// Drift represents a single resource tag changed outside Terraform.
+type Drift struct {
+	Action   DriftAction
+	Key      string
+	NewValue string
+	OldValue string
+}
+
+func (d Drift) String() string {
+	switch d.Action {
+	case DriftActionAdded:
+		return fmt.Sprintf("%q added with value %q", d.Key, d.NewValue)
+	case DriftActionChanged:
+		return fmt.Sprintf("%q changed from %q to %q", d.Key, d.OldValue, d.NewValue)
+	case DriftActionRemoved:
+		return fmt.Sprintf("%q removed, was %q", d.Key, d.OldValue)
+	default:
+		return d.Key
+	}
+}
+
+// Drift returns the tags added, changed or removed since old was read, ordered by key.
+func (tags KeyValueTags) Drift(old KeyValueTags) []Drift {
+	var drift []Drift
+
+	for k, v := range tags {
+		newValue := v.ValueString()
+		if v, ok := old[k]; !ok {
+			drift = append(drift, Drift{Action: DriftActionAdded, Key: k, NewValue: newValue})
+		} else if oldValue := v.ValueString(); oldValue != newValue {
+			drift = append(drift, Drift{Action: DriftActionChanged, Key: k, NewValue: newValue, OldValue: oldValue})
+		}
+	}
+
+	for k, v := range old {
+		if _, ok := tags[k]; !ok {
+			drift = append(drift, Drift{Action: DriftActionRemoved, Key: k, OldValue: v.ValueString()})
+		}
+	}
+
+	slices.SortFunc(drift, func(a, b Drift) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return drift
+}
+
+// KeyPrefixSuggestions returns the prefixes of tag keys added outside Terraform that could be added to the provider's ignore_tags key_prefixes, ordered.
+// Only keys containing DriftKeyPrefixDelimiter have a prefix.
+// Prefixes shared with any configured tag key are never suggested, as ignoring them would also ignore the configured tags.
+func (c *DriftConfig) KeyPrefixSuggestions(drift []Drift, configured KeyValueTags) []string {
+	if c == nil || !c.SuggestIgnoreKeyPrefixes {
+		return nil
+	}
+
+	configuredKeys := configured.Keys()
+
+	prefixes := make(map[string]struct{})
+	for _, d := range drift {
+		if d.Action != DriftActionAdded {
+			continue
+		}
+
+		prefix, _, ok := strings.Cut(d.Key, DriftKeyPrefixDelimiter)
+		if !ok || prefix == "" {
+			continue
+		}
+		prefix += DriftKeyPrefixDelimiter
+
+		if slices.ContainsFunc(configuredKeys, func(k string) bool { return strings.HasPrefix(k, prefix) }) {
+			continue
+		}
+
+		prefixes[prefix] = struct{}{}
+	}
+
+	if len(prefixes) == 0 {
+		return nil
+	}
+
+	return slices.Sorted(maps.Keys(prefixes))
+}
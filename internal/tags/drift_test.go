@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKeyValueTagsDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name string
+		old  KeyValueTags
+		new  KeyValueTags
+		want []Drift
+	}{
+		{
+			name: "no drift",
+			old:  New(ctx, map[string]string{"key1": "value1"}),
+			new:  New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "drift",
+			old: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			new: New(ctx, map[string]string{
+				"config:remediated": "true",
+				"key1":              "value1",
+				"key2":              "value2updated",
+			}),
+			want: []Drift{
+				{Action: DriftActionAdded, Key: "config:remediated", NewValue: "true"},
+				{Action: DriftActionChanged, Key: "key2", NewValue: "value2updated", OldValue: "value2"},
+				{Action: DriftActionRemoved, Key: "key3", OldValue: "value3"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.new.Drift(testCase.old)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDriftConfigKeyPrefixSuggestions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	drift := []Drift{
+		{Action: DriftActionAdded, Key: "config:remediated", NewValue: "true"},
+		{Action: DriftActionAdded, Key: "cost:center", NewValue: "1234"},
+		{Action: DriftActionAdded, Key: "cost:owner", NewValue: "team"},
+		{Action: DriftActionAdded, Key: "owner", NewValue: "team"},
+		{Action: DriftActionAdded, Key: "team:name", NewValue: "platform"},
+		{Action: DriftActionChanged, Key: "env:name", NewValue: "prod", OldValue: "dev"},
+	}
+	configured := New(ctx, map[string]string{
+		"key1":      "value1",
+		"team:lead": "alice",
+	})
+
+	var nilConfig *DriftConfig
+	if got := nilConfig.KeyPrefixSuggestions(drift, configured); got != nil {
+		t.Errorf("KeyPrefixSuggestions() = %v, want nil", got)
+	}
+
+	config := &DriftConfig{}
+	if got := config.KeyPrefixSuggestions(drift, configured); got != nil {
+		t.Errorf("KeyPrefixSuggestions() = %v, want nil", got)
+	}
+
+	config.SuggestIgnoreKeyPrefixes = true
+	if diff := cmp.Diff(config.KeyPrefixSuggestions(drift, configured), []string{"config:", "cost:"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Suggestions do not change any ignore configuration.
+	if diff := cmp.Diff(config.KeyPrefixSuggestions(drift, nil), []string{"config:", "cost:", "team:"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
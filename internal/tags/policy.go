@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// PolicyEnforcement is the action taken when resource tags violate the tag policy.
+type PolicyEnforcement string
+
+const (
+	PolicyEnforcementDisabled PolicyEnforcement = "disabled"
+	PolicyEnforcementError    PolicyEnforcement = "error"
+	PolicyEnforcementWarning  PolicyEnforcement = "warning"
+)
+
+func (PolicyEnforcement) Values() []PolicyEnforcement {
+	return []PolicyEnforcement{
+		PolicyEnforcementDisabled,
+		PolicyEnforcementError,
+		PolicyEnforcementWarning,
+	}
+}
+
+// PolicyKeyCase is the letter case required of tag keys.
+type PolicyKeyCase string
+
+const (
+	PolicyKeyCaseCamel  PolicyKeyCase = "camel"
+	PolicyKeyCaseKebab  PolicyKeyCase = "kebab"
+	PolicyKeyCaseLower  PolicyKeyCase = "lower"
+	PolicyKeyCasePascal PolicyKeyCase = "pascal"
+	PolicyKeyCaseSnake  PolicyKeyCase = "snake"
+	PolicyKeyCaseUpper  PolicyKeyCase = "upper"
+)
+
+func (PolicyKeyCase) Values() []PolicyKeyCase {
+	return []PolicyKeyCase{
+		PolicyKeyCaseCamel,
+		PolicyKeyCaseKebab,
+		PolicyKeyCaseLower,
+		PolicyKeyCasePascal,
+		PolicyKeyCaseSnake,
+		PolicyKeyCaseUpper,
+	}
+}
+
+var policyKeyCaseRegexps = map[PolicyKeyCase]*regexp.Regexp{
+	PolicyKeyCaseCamel:  regexache.MustCompile(`^[a-z][0-9A-Za-z]*$`),
+	PolicyKeyCaseKebab:  regexache.MustCompile(`^[0-9a-z]+(-[0-9a-z]+)*$`),
+	PolicyKeyCasePascal: regexache.MustCompile(`^[A-Z][0-9A-Za-z]*$`),
+	PolicyKeyCaseSnake:  regexache.MustCompile(`^[0-9a-z]+(_[0-9a-z]+)*$`),
+}
+
+func (c PolicyKeyCase) matches(key string) bool {
+	switch c {
+	case "":
+		return true
+	case PolicyKeyCaseLower:
+		return key == strings.ToLower(key)
+	case PolicyKeyCaseUpper:
+		return key == strings.ToUpper(key)
+	}
+
+	if re, ok := policyKeyCaseRegexps[c]; ok {
+		return re.MatchString(key)
+	}
+
+	return true
+}
+
+// PolicyConfig contains the rules that resource tags must follow.
+type PolicyConfig struct {
+	// Enforcement is the default action taken on violations.
+	Enforcement PolicyEnforcement
+	KeyCase     PolicyKeyCase
+	// RequiredKeys are tag keys that every tagged resource must have.
+	RequiredKeys []string
+	// ResourceEnforcement overrides Enforcement by resource type name.
+	ResourceEnforcement map[string]PolicyEnforcement
+	Tags                []PolicyTag
+}
+
+// PolicyTag contains the rules for the values of a single tag key.
+type PolicyTag struct {
+	AllowedValues []string
+	Key           string
+	ValuePattern  *regexp.Regexp
+}
+
+// EnforcementFor returns the action taken on violations by the specified resource type.
+func (pc *PolicyConfig) EnforcementFor(typeName string) PolicyEnforcement {
+	if pc == nil {
+		return PolicyEnforcementDisabled
+	}
+
+	if v, ok := pc.ResourceEnforcement[typeName]; ok {
+		return v
+	}
+
+	if pc.Enforcement == "" {
+		return PolicyEnforcementError
+	}
+
+	return pc.Enforcement
+}
+
+// Violations returns a description of each way in which the specified tags violate the tag policy.
+// Tags with unknown (nil) values are checked for presence only. System tags are not checked.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, key := range pc.RequiredKeys {
+		if _, ok := tags[key]; !ok {
+			violations = append(violations, fmt.Sprintf("missing required tag %q", key))
+		}
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if strings.HasPrefix(key, awsTagKeyPrefix) {
+			continue
+		}
+
+		if !pc.KeyCase.matches(key) {
+			violations = append(violations, fmt.Sprintf("tag key %q is not %s case", key, pc.KeyCase))
+		}
+	}
+
+	for _, rule := range pc.Tags {
+		v, ok := tags[rule.Key]
+		if !ok || v == nil || v.Value == nil {
+			continue
+		}
+		value := *v.Value
+
+		if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of: %s", rule.Key, value, strings.Join(rule.AllowedValues, ", ")))
+		}
+
+		if rule.ValuePattern != nil && !rule.ValuePattern.MatchString(value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q does not match pattern %q", rule.Key, value, rule.ValuePattern))
+		}
+	}
+
+	return violations
+}
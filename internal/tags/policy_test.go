@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigEnforcementFor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		typeName     string
+		want         PolicyEnforcement
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_vpc",
+			want:     PolicyEnforcementDisabled,
+		},
+		{
+			name:         "empty config",
+			policyConfig: &PolicyConfig{},
+			typeName:     "aws_vpc",
+			want:         PolicyEnforcementError,
+		},
+		{
+			name: "default enforcement",
+			policyConfig: &PolicyConfig{
+				Enforcement: PolicyEnforcementWarning,
+			},
+			typeName: "aws_vpc",
+			want:     PolicyEnforcementWarning,
+		},
+		{
+			name: "resource enforcement",
+			policyConfig: &PolicyConfig{
+				Enforcement: PolicyEnforcementWarning,
+				ResourceEnforcement: map[string]PolicyEnforcement{
+					"aws_vpc": PolicyEnforcementDisabled,
+				},
+			},
+			typeName: "aws_vpc",
+			want:     PolicyEnforcementDisabled,
+		},
+		{
+			name: "other resource enforcement",
+			policyConfig: &PolicyConfig{
+				Enforcement: PolicyEnforcementWarning,
+				ResourceEnforcement: map[string]PolicyEnforcement{
+					"aws_subnet": PolicyEnforcementError,
+				},
+			},
+			typeName: "aws_vpc",
+			want:     PolicyEnforcementWarning,
+		},
+		{
+			name: "default enforcement disabled",
+			policyConfig: &PolicyConfig{
+				Enforcement: PolicyEnforcementDisabled,
+				ResourceEnforcement: map[string]PolicyEnforcement{
+					"aws_subnet": PolicyEnforcementError,
+				},
+			},
+			typeName: "aws_vpc",
+			want:     PolicyEnforcementDisabled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.policyConfig.EnforcementFor(testCase.typeName), testCase.want; got != want {
+				t.Errorf("got %s; want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		want         []string
+	}{
+		{
+			name: "nil config",
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "no violations",
+			policyConfig: &PolicyConfig{
+				KeyCase:      PolicyKeyCasePascal,
+				RequiredKeys: []string{"CostCenter", "Environment"},
+				Tags: []PolicyTag{
+					{
+						AllowedValues: []string{"dev", "prod"},
+						Key:           "Environment",
+					},
+					{
+						Key:          "CostCenter",
+						ValuePattern: regexache.MustCompile(`^\d{4}$`),
+					},
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+			}),
+		},
+		{
+			name: "missing required keys",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Environment"},
+			},
+			tags: New(ctx, map[string]string{
+				"Environment": "prod",
+			}),
+			want: []string{
+				`missing required tag "CostCenter"`,
+			},
+		},
+		{
+			name: "disallowed value",
+			policyConfig: &PolicyConfig{
+				Tags: []PolicyTag{
+					{
+						AllowedValues: []string{"dev", "prod"},
+						Key:           "Environment",
+					},
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"Environment": "test",
+			}),
+			want: []string{
+				`tag "Environment" value "test" is not one of: dev, prod`,
+			},
+		},
+		{
+			name: "value pattern mismatch",
+			policyConfig: &PolicyConfig{
+				Tags: []PolicyTag{
+					{
+						Key:          "CostCenter",
+						ValuePattern: regexache.MustCompile(`^\d{4}$`),
+					},
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "12345",
+			}),
+			want: []string{
+				`tag "CostCenter" value "12345" does not match pattern "^\\d{4}$"`,
+			},
+		},
+		{
+			name: "key case",
+			policyConfig: &PolicyConfig{
+				KeyCase: PolicyKeyCaseKebab,
+			},
+			tags: New(ctx, map[string]string{
+				"aws:cloudformation:stack-name": "stack",
+				"cost-center":                   "1234",
+				"costCenter":                    "1234",
+				"cost_center":                   "1234",
+			}),
+			want: []string{
+				`tag key "costCenter" is not kebab case`,
+				`tag key "cost_center" is not kebab case`,
+			},
+		},
+		{
+			name: "unknown value",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"Environment"},
+				Tags: []PolicyTag{
+					{
+						AllowedValues: []string{"dev", "prod"},
+						Key:           "Environment",
+					},
+				},
+			},
+			tags: New(ctx, map[string]*string{
+				"Environment": nil,
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Violations(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+const (
+	// Structured import ID keys.
+	identityKeyAccountID = "account_id"
+	identityKeyRegion    = "region"
+
+	defaultIdentitySeparator = ","
+)
+
+// ServicePackageResourceIdentity represents resource-level structured identity information.
+// A resource is identified either by its ARN or by a set of attributes within an AWS account and Region.
+type ServicePackageResourceIdentity struct {
+	ARNAttribute string   // The attribute for the resource's ARN, if the ARN identifies the resource.
+	Attributes   []string // The identifying attributes, in the order they appear in the resource's import ID.
+	Global       bool     // Whether the resource is global, i.e. not identified by Region.
+	Separator    string   // The separator between identifying attribute values in the resource's import ID. Defaults to ",".
+}
+
+// ResourceIdentity is the structured identity of a single resource.
+type ResourceIdentity struct {
+	AccountID  string
+	Region     string
+	Attributes map[string]string
+}
+
+// IdentifyingAttributes returns the names of the resource's identifying attributes.
+func (ri *ServicePackageResourceIdentity) IdentifyingAttributes() []string {
+	if ri.ARNAttribute != "" {
+		return []string{ri.ARNAttribute}
+	}
+
+	return ri.Attributes
+}
+
+// ParseImportID parses a resource import ID.
+// A structured import ID is a JSON object containing the identifying attributes and, optionally, `account_id` and `region`, e.g.
+//
+//	{"account_id":"123456789012","region":"us-west-2","bucket":"example"}
+//
+// ARN-identified resources may also be imported by ARN.
+// Returns the resource's identity, or nil for an opaque import ID, and the import ID to pass to the resource's importer.
+func (ri *ServicePackageResourceIdentity) ParseImportID(id string) (*ResourceIdentity, string, error) {
+	if !strings.HasPrefix(strings.TrimSpace(id), "{") {
+		if ri.ARNAttribute != "" && arn.IsARN(id) {
+			identity, err := ri.FromARN(id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return identity, id, nil
+		}
+
+		return nil, id, nil
+	}
+
+	var m map[string]string
+	decoder := json.NewDecoder(bytes.NewReader([]byte(id)))
+	if err := decoder.Decode(&m); err != nil {
+		return nil, "", fmt.Errorf("parsing structured import ID: %w", err)
+	}
+
+	identity := &ResourceIdentity{
+		AccountID:  m[identityKeyAccountID],
+		Region:     m[identityKeyRegion],
+		Attributes: make(map[string]string),
+	}
+	delete(m, identityKeyAccountID)
+	delete(m, identityKeyRegion)
+
+	if ri.Global && identity.Region != "" {
+		return nil, "", fmt.Errorf("structured import ID: %q is not valid for a global resource", identityKeyRegion)
+	}
+
+	keys := ri.IdentifyingAttributes()
+
+	for k, v := range m {
+		if !slices.Contains(keys, k) {
+			return nil, "", fmt.Errorf("structured import ID: unexpected key %q, expected %s", k, strings.Join(keys, ", "))
+		}
+		identity.Attributes[k] = v
+	}
+
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := identity.Attributes[k]
+		if v == "" {
+			return nil, "", fmt.Errorf("structured import ID: missing required key %q", k)
+		}
+		values = append(values, v)
+	}
+
+	if ri.ARNAttribute != "" {
+		fromARN, err := ri.FromARN(values[0])
+		if err != nil {
+			return nil, "", err
+		}
+		if err := fromARN.Validate(identity.AccountID, identity.Region); err != nil {
+			return nil, "", fmt.Errorf("structured import ID: %w", err)
+		}
+
+		return fromARN, values[0], nil
+	}
+
+	separator := ri.Separator
+	if separator == "" {
+		separator = defaultIdentitySeparator
+	}
+
+	return identity, strings.Join(values, separator), nil
+}
+
+// FromARN returns the identity of an ARN-identified resource.
+func (ri *ServicePackageResourceIdentity) FromARN(s string) (*ResourceIdentity, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("parsing resource identity ARN (%s): %w", s, err)
+	}
+
+	identity := &ResourceIdentity{
+		AccountID: v.AccountID,
+		Attributes: map[string]string{
+			ri.ARNAttribute: s,
+		},
+	}
+	if !ri.Global {
+		identity.Region = v.Region
+	}
+
+	return identity, nil
+}
+
+// Validate returns an error if the identity's AWS account ID or Region don't match those specified.
+// Empty values are not compared.
+func (i *ResourceIdentity) Validate(accountID, region string) error {
+	if i.AccountID != "" && accountID != "" && i.AccountID != accountID {
+		return fmt.Errorf("resource identity account ID (%s) does not match the provider account ID (%s)", i.AccountID, accountID)
+	}
+
+	if i.Region != "" && region != "" && i.Region != region {
+		return fmt.Errorf("resource identity Region (%s) does not match the provider Region (%s)", i.Region, region)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServicePackageResourceIdentityParseImportID(t *testing.T) {
+	t.Parallel()
+
+	attributeIdentity := &ServicePackageResourceIdentity{
+		Attributes: []string{"cluster", "name"},
+		Separator:  "/",
+	}
+	arnIdentity := &ServicePackageResourceIdentity{
+		ARNAttribute: "arn",
+	}
+	globalIdentity := &ServicePackageResourceIdentity{
+		Attributes: []string{"name"},
+		Global:     true,
+	}
+
+	testCases := []struct {
+		name         string
+		identity     *ServicePackageResourceIdentity
+		id           string
+		wantIdentity *ResourceIdentity
+		wantID       string
+		wantErr      bool
+	}{
+		{
+			name:     "opaque",
+			identity: attributeIdentity,
+			id:       "c1/n1",
+			wantID:   "c1/n1",
+		},
+		{
+			name:     "structured",
+			identity: attributeIdentity,
+			id:       `{"account_id":"123456789012","region":"us-west-2","cluster":"c1","name":"n1"}`, //lintignore:AWSAT003
+			wantIdentity: &ResourceIdentity{
+				AccountID:  "123456789012",
+				Region:     "us-west-2", //lintignore:AWSAT003
+				Attributes: map[string]string{"cluster": "c1", "name": "n1"},
+			},
+			wantID: "c1/n1",
+		},
+		{
+			name:     "structured missing key",
+			identity: attributeIdentity,
+			id:       `{"cluster":"c1"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "structured unexpected key",
+			identity: attributeIdentity,
+			id:       `{"cluster":"c1","name":"n1","arn":"a"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "structured invalid JSON",
+			identity: attributeIdentity,
+			id:       `{"cluster":`,
+			wantErr:  true,
+		},
+		{
+			name:     "global",
+			identity: globalIdentity,
+			id:       `{"account_id":"123456789012","name":"n1"}`,
+			wantIdentity: &ResourceIdentity{
+				AccountID:  "123456789012",
+				Attributes: map[string]string{"name": "n1"},
+			},
+			wantID: "n1",
+		},
+		{
+			name:     "global with region",
+			identity: globalIdentity,
+			id:       `{"region":"us-west-2","name":"n1"}`, //lintignore:AWSAT003
+			wantErr:  true,
+		},
+		{
+			name:     "ARN",
+			identity: arnIdentity,
+			id:       "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+			wantIdentity: &ResourceIdentity{
+				AccountID:  "123456789012",
+				Region:     "us-west-2",                                                                                                             //lintignore:AWSAT003
+				Attributes: map[string]string{"arn": "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"}, //lintignore:AWSAT003,AWSAT005
+			},
+			wantID: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:     "structured ARN",
+			identity: arnIdentity,
+			id:       `{"arn":"arn:aws:sns:us-west-2:123456789012:my-topic"}`, //lintignore:AWSAT003,AWSAT005
+			wantIdentity: &ResourceIdentity{
+				AccountID:  "123456789012",
+				Region:     "us-west-2",                                                             //lintignore:AWSAT003
+				Attributes: map[string]string{"arn": "arn:aws:sns:us-west-2:123456789012:my-topic"}, //lintignore:AWSAT003,AWSAT005
+			},
+			wantID: "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:     "structured ARN account mismatch",
+			identity: arnIdentity,
+			id:       `{"account_id":"210987654321","arn":"arn:aws:sns:us-west-2:123456789012:my-topic"}`, //lintignore:AWSAT003,AWSAT005
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotIdentity, gotID, err := testCase.identity.ParseImportID(testCase.id)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ParseImportID(%q) err %t, want %t: %s", testCase.id, got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(gotIdentity, testCase.wantIdentity); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := gotID, testCase.wantID; got != want {
+				t.Errorf("ParseImportID(%q) ID = %q, want %q", testCase.id, got, want)
+			}
+		})
+	}
+}
+
+func TestResourceIdentityValidate(t *testing.T) {
+	t.Parallel()
+
+	identity := &ResourceIdentity{
+		AccountID: "123456789012",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	for _, tc := range []struct {
+		accountID string
+		region    string
+		valid     bool
+	}{
+		{"123456789012", "us-west-2", true}, //lintignore:AWSAT003
+		{"", "", true},
+		{"210987654321", "us-west-2", false}, //lintignore:AWSAT003
+		{"123456789012", "us-east-1", false}, //lintignore:AWSAT003
+	} {
+		err := identity.Validate(tc.accountID, tc.region)
+		if got, want := err == nil, tc.valid; got != want {
+			t.Errorf("Validate(%q, %q) = %v, want valid %v", tc.accountID, tc.region, err, want)
+		}
+	}
+}
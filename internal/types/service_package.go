@@ -36,25 +36,30 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory        func(context.Context) (resource.ResourceWithConfigure, error)
+	Name           string
+	Tags           *ServicePackageResourceTags
+	Identity       *ServicePackageResourceIdentity
+	RegionOverride bool // Supports the per-resource `region` and `assume_role_arn` arguments.
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
 // implemented by a service package.
 type ServicePackageSDKDataSource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory        func() *schema.Resource
+	TypeName       string
+	Name           string
+	Tags           *ServicePackageResourceTags
+	RegionOverride bool // Supports the per-resource `region` and `assume_role_arn` arguments.
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory        func() *schema.Resource
+	TypeName       string
+	Name           string
+	Tags           *ServicePackageResourceTags
+	Identity       *ServicePackageResourceIdentity
+	RegionOverride bool // Supports the per-resource `region` and `assume_role_arn` arguments.
 }